@@ -0,0 +1,260 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package embedded runs a single-node Weaviate in-process. It starts the same
+// components as the server binary, but does not serve the public REST, GraphQL
+// or gRPC APIs. Instead the use-case managers are exposed directly, so that
+// tests and edge deployments can work with Weaviate without any network
+// round trips.
+//
+// The configuration of an instance starts out like the one of the server
+// binary, read from the environment of the process, and Options take
+// precedence over it. The environment is only read, so several instances
+// with their own data paths and ports can run in the same process. Modules
+// still read their own settings, such as API keys, from the environment.
+package embedded
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/traverser"
+	"github.com/weaviate/weaviate/usecases/usage"
+)
+
+const (
+	DefaultHostname     = "embedded"
+	DefaultReadyTimeout = 2 * time.Minute
+)
+
+// Options configures an embedded instance. All zero values fall back to the
+// defaults of the server binary, except where noted.
+type Options struct {
+	// DataPath is the directory all data is persisted in. Required.
+	DataPath string
+	// Hostname is the node name, defaults to DefaultHostname
+	Hostname string
+
+	// Ports used for intra-cluster communication. Even a single node needs
+	// them for its raft store; they are only bound on localhost. Like for
+	// any node running in localhost mode, the raft internal rpc port has to
+	// be the raft port + 1.
+	GossipBindPort      int
+	RaftPort            int
+	RaftInternalRPCPort int
+
+	// EnableModules is a comma separated list of modules to load, like the
+	// ENABLE_MODULES environment variable
+	EnableModules string
+	// DefaultVectorizerModule defaults to "none"
+	DefaultVectorizerModule string
+
+	// Configure may change any further settings. It is called with the
+	// configuration read from the environment after the fields above were
+	// applied to it.
+	Configure func(cfg *config.Config)
+
+	// ReadyTimeout bounds how long Start waits for the node to become ready,
+	// defaults to DefaultReadyTimeout
+	ReadyTimeout time.Duration
+}
+
+func (o Options) validate() error {
+	if o.DataPath == "" {
+		return fmt.Errorf("data path must be set")
+	}
+	for name, port := range map[string]int{
+		"gossip bind port":       o.GossipBindPort,
+		"raft port":              o.RaftPort,
+		"raft internal rpc port": o.RaftInternalRPCPort,
+	} {
+		if port < 0 {
+			return fmt.Errorf("%s must not be negative", name)
+		}
+	}
+	if o.RaftPort > 0 && o.RaftInternalRPCPort > 0 && o.RaftInternalRPCPort != o.RaftPort+1 {
+		return fmt.Errorf("raft internal rpc port must be raft port + 1")
+	}
+	return nil
+}
+
+func (o Options) config() (*config.Config, error) {
+	cfg := &config.Config{}
+	if err := config.FromEnv(cfg); err != nil {
+		return nil, err
+	}
+
+	hostname := o.Hostname
+	if hostname == "" {
+		hostname = DefaultHostname
+	}
+	vectorizer := o.DefaultVectorizerModule
+	if vectorizer == "" {
+		vectorizer = config.VectorizerModuleNone
+	}
+
+	cfg.Persistence.DataPath = o.DataPath
+	cfg.Cluster.Hostname = hostname
+	cfg.Cluster.Localhost = true
+	// a single node never needs to be reached from another host
+	cfg.Cluster.AdvertiseAddr = "127.0.0.1"
+	cfg.Cluster.Join = ""
+	cfg.DefaultVectorizerModule = vectorizer
+	cfg.DisableTelemetry = true
+
+	if o.GossipBindPort > 0 {
+		cfg.Cluster.GossipBindPort = o.GossipBindPort
+		cfg.Cluster.DataBindPort = o.GossipBindPort + 1
+	}
+	if o.RaftPort > 0 {
+		cfg.Raft.Port = o.RaftPort
+	}
+	if o.RaftInternalRPCPort > 0 {
+		cfg.Raft.InternalRPCPort = o.RaftInternalRPCPort
+	}
+	// the node bootstraps a raft cluster of its own
+	cfg.Raft.Join = []string{fmt.Sprintf("%s:%d", hostname, cfg.Raft.InternalRPCPort)}
+	cfg.Raft.BootstrapExpect = 1
+	if o.EnableModules != "" {
+		cfg.EnableModules = o.EnableModules
+	}
+
+	if o.Configure != nil {
+		o.Configure(cfg)
+	}
+	return cfg, nil
+}
+
+// Weaviate is a running embedded instance. All managers are used with a nil
+// principal, authentication and authorization only apply if they are
+// explicitly configured through Options.Configure.
+type Weaviate struct {
+	appState *state.State
+	objects  *objects.Manager
+	usage    *usage.Reporter
+}
+
+// Start starts an embedded instance and blocks until it is ready to serve
+// requests or ctx is done. Unlike the server binary, Start returns an error
+// if the configuration is invalid or a component fails to start, and it does
+// not bind any ports apart from the ones for gossip and raft.
+func Start(ctx context.Context, opts Options) (*Weaviate, error) {
+	if err := opts.validate(); err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+
+	cfg, err := opts.config()
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	appState, err := rest.NewAppState(ctx, config.GetConfigOptionGroup(),
+		rest.AppStateOptions{Embedded: true, Config: cfg})
+	if err != nil {
+		return nil, fmt.Errorf("start embedded node: %w", err)
+	}
+
+	objectsManager := objects.NewManager(appState.SchemaManager, appState.ServerConfig, appState.Logger,
+		appState.Authorizer, appState.DB, appState.Modules,
+		objects.NewMetrics(appState.Metrics), appState.MemWatch)
+	objectsManager.SetMasker(appState.Masker)
+	objectsManager.SetQueryCounter(appState.UsageQueries)
+
+	w := &Weaviate{
+		appState: appState,
+		objects:  objectsManager,
+	}
+
+	if w.usage, err = rest.StartUsageReporter(appState); err != nil {
+		w.Close(context.Background())
+		return nil, err
+	}
+
+	timeout := opts.ReadyTimeout
+	if timeout <= 0 {
+		timeout = DefaultReadyTimeout
+	}
+	if err := w.waitForReady(ctx, timeout); err != nil {
+		w.Close(context.Background())
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (w *Weaviate) waitForReady(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		if w.appState.ClusterService.Ready() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for embedded node to become ready: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// Objects gives access to single object CRUD and listing
+func (w *Weaviate) Objects() *objects.Manager {
+	return w.objects
+}
+
+// Batch gives access to batch imports and deletes
+func (w *Weaviate) Batch() *objects.BatchManager {
+	return w.appState.BatchManager
+}
+
+// Schema gives access to collection and tenant management
+func (w *Weaviate) Schema() *schema.Manager {
+	return w.appState.SchemaManager
+}
+
+// Traverser gives access to Get, Aggregate and Explore queries
+func (w *Weaviate) Traverser() *traverser.Traverser {
+	return w.appState.Traverser
+}
+
+// Close stops all background work of the instance, flushes all data to disk
+// and releases its ports.
+func (w *Weaviate) Close(ctx context.Context) error {
+	var errs []error
+	// like telemetry in the server binary, the final usage report must be
+	// written before the db is shut down
+	if w.usage != nil {
+		if err := w.usage.Stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("stop usage reporting: %w", err))
+		}
+	}
+
+	if err := rest.CloseAppState(ctx, w.appState); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("close embedded node: %w", err)
+	}
+	return nil
+}
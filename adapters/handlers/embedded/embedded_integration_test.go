@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package embedded
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestEmbeddedJourney(t *testing.T) {
+	ctx := context.Background()
+	dataPath := t.TempDir()
+	id := strfmt.UUID("8f8e3d7a-7ad3-4a6b-9d60-5f2e3b1c8a01")
+
+	gossipPort, raftPort := freePorts(t), freePorts(t)
+	opts := Options{
+		DataPath:            dataPath,
+		GossipBindPort:      gossipPort,
+		RaftPort:            raftPort,
+		RaftInternalRPCPort: raftPort + 1,
+	}
+
+	t.Run("failed start releases its ports", func(t *testing.T) {
+		failing := opts
+		failing.Configure = func(cfg *config.Config) { cfg.Monitoring.Enabled = true }
+		_, err := Start(ctx, failing)
+		require.NotNil(t, err)
+	})
+
+	w, err := Start(ctx, opts)
+	require.Nil(t, err)
+
+	_, _, err = w.Schema().AddClass(ctx, nil, &models.Class{
+		Class:      "Article",
+		Vectorizer: "none",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+		},
+	})
+	require.Nil(t, err)
+
+	_, err = w.Objects().AddObject(ctx, nil, &models.Object{
+		ID:         id,
+		Class:      "Article",
+		Properties: map[string]interface{}{"title": "embedded"},
+		Vector:     []float32{0.1, 0.2, 0.3},
+	}, nil)
+	require.Nil(t, err)
+
+	obj, err := w.Objects().GetObject(ctx, nil, "Article", id, additional.Properties{}, nil, nil, "")
+	require.Nil(t, err)
+	assert.Equal(t, "embedded", obj.Properties.(map[string]interface{})["title"])
+
+	require.Nil(t, w.Close(ctx))
+
+	t.Run("restart on the same ports and data path", func(t *testing.T) {
+		w, err := Start(ctx, opts)
+		require.Nil(t, err)
+		defer func() { require.Nil(t, w.Close(ctx)) }()
+
+		obj, err := w.Objects().GetObject(ctx, nil, "Article", id, additional.Properties{}, nil, nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, "embedded", obj.Properties.(map[string]interface{})["title"])
+	})
+
+	t.Run("two instances in the same process", func(t *testing.T) {
+		w, err := Start(ctx, opts)
+		require.Nil(t, err)
+		defer func() { require.Nil(t, w.Close(ctx)) }()
+
+		otherRaftPort := freePorts(t)
+		other, err := Start(ctx, Options{
+			DataPath:            t.TempDir(),
+			Hostname:            "other",
+			GossipBindPort:      freePorts(t),
+			RaftPort:            otherRaftPort,
+			RaftInternalRPCPort: otherRaftPort + 1,
+		})
+		require.Nil(t, err)
+		defer func() { require.Nil(t, other.Close(ctx)) }()
+
+		class, err := other.Schema().GetClass(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Nil(t, class, "the instances must not share any data")
+
+		obj, err := w.Objects().GetObject(ctx, nil, "Article", id, additional.Properties{}, nil, nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, "embedded", obj.Properties.(map[string]interface{})["title"])
+	})
+}
+
+// freePorts returns a port p, such that p and p+1 were free a moment ago.
+// Both the gossip and the raft port need their successor.
+func freePorts(t *testing.T) int {
+	for i := 0; i < 100; i++ {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.Nil(t, err)
+		port := l.Addr().(*net.TCPAddr).Port
+
+		next, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port+1))
+		l.Close()
+		if err == nil {
+			next.Close()
+			return port
+		}
+	}
+	t.Fatal("no two consecutive free ports found")
+	return 0
+}
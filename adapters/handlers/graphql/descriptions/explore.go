@@ -28,4 +28,6 @@ const (
 	ID                   = "Concept identifier in the uuid format"
 	Beacon               = "Concept identifier in the beacon format, such as weaviate://<hostname>/<kind>/id"
 	Target               = "Configure how multi target searches are combined"
+	ExploreCollections   = "Restrict the exploration to these collections and merge their results by weighted score"
+	ExploreScore         = "Similarity of the result multiplied by the weight of its collection"
 )
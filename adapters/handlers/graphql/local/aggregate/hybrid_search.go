@@ -113,6 +113,8 @@ func hybridOperands(classObject *graphql.Object,
 		},
 	}
 
+	fieldMap = common_filters.AddKeywordOverrideFields(prefixName+"Aggregate", fieldMap)
+
 	if os.Getenv("ENABLE_EXPERIMENTAL_HYBRID_OPERANDS") != "" {
 		fieldMap["operands"] = &graphql.InputObjectFieldConfig{
 			Description: "Subsearch list",
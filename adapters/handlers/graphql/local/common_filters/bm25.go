@@ -31,6 +31,8 @@ func ExtractBM25(source map[string]interface{}, explainScore bool) searchparams.
 		args.Query = query.(string)
 	}
 
+	args.Stopwords = extractStopwords(source)
+	args.SearchOperator = extractKeywordOperator(source)
 	args.AdditionalExplanations = explainScore
 	args.Type = "bm25"
 
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func TestExtractBM25(t *testing.T) {
+	t.Run("without overrides", func(t *testing.T) {
+		args := ExtractBM25(map[string]interface{}{
+			"query":      "the part",
+			"properties": []interface{}{"name"},
+		}, false)
+		assert.Equal(t, searchparams.KeywordRanking{
+			Type:       "bm25",
+			Query:      "the part",
+			Properties: []string{"name"},
+		}, args)
+	})
+
+	t.Run("with overrides", func(t *testing.T) {
+		args := ExtractBM25(map[string]interface{}{
+			"query": "the part",
+			"stopwords": map[string]interface{}{
+				"preset":   "en",
+				"removals": []interface{}{"the"},
+			},
+		}, true)
+		assert.Equal(t, searchparams.KeywordRanking{
+			Type:                   "bm25",
+			Query:                  "the part",
+			AdditionalExplanations: true,
+			Stopwords:              &models.StopwordConfig{Preset: "en", Removals: []string{"the"}},
+		}, args)
+	})
+	t.Run("with search operator", func(t *testing.T) {
+		args := ExtractBM25(map[string]interface{}{
+			"query": "the part",
+			"searchOperator": map[string]interface{}{
+				"operator":           "or",
+				"minimumShouldMatch": "75%",
+			},
+		}, false)
+		assert.Equal(t, searchparams.KeywordRanking{
+			Type:  "bm25",
+			Query: "the part",
+			SearchOperator: searchparams.KeywordOperator{
+				Operator: searchparams.KeywordOperatorOr, MinimumShouldMatch: "75%",
+			},
+		}, args)
+	})
+}
@@ -163,6 +163,8 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 		}
 	}
 
+	args.Stopwords = extractStopwords(source)
+	args.SearchOperator = extractKeywordOperator(source)
 	args.Type = "hybrid"
 
 	if args.NearTextParams != nil && args.NearVectorParams != nil {
@@ -49,6 +49,27 @@ func TestHybrid(t *testing.T) {
 			output:            &searchparams.HybridSearch{NearVectorParams: &searchparams.NearVector{Vectors: []models.Vector{[]float32{1, 2, 3}, []float32{1, 2}}}, TargetVectors: []string{"target1", "target2"}, SubSearches: ss, Type: "hybrid", Alpha: 0.75, FusionAlgorithm: 1},
 			outputCombination: &dto.TargetCombination{Type: dto.Minimum, Weights: nilweights},
 		},
+		{
+			input: map[string]interface{}{
+				"query":     "part 7 a",
+				"stopwords": map[string]interface{}{"preset": "none", "additions": []interface{}{"part"}},
+			},
+			output: &searchparams.HybridSearch{
+				Query: "part 7 a", SubSearches: ss, Type: "hybrid", Alpha: 0.75, FusionAlgorithm: 1,
+				Stopwords: &models.StopwordConfig{Preset: "none", Additions: []string{"part"}, Removals: nil},
+			},
+			outputCombination: nil,
+		},
+		{
+			input: map[string]interface{}{
+				"query": "part 7 a", "searchOperator": map[string]interface{}{"operator": "and"},
+			},
+			output: &searchparams.HybridSearch{
+				Query: "part 7 a", SubSearches: ss, Type: "hybrid", Alpha: 0.75, FusionAlgorithm: 1,
+				SearchOperator: searchparams.KeywordOperator{Operator: searchparams.KeywordOperatorAnd},
+			},
+			outputCombination: nil,
+		},
 	}
 
 	for _, tt := range cases {
@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// AddKeywordOverrideFields adds the arguments which override the stopwords of
+// the class for a single bm25 or hybrid query, as well as the operator that
+// decides how many of the query terms a result has to contain. The query is
+// always tokenized like the searched properties, as its terms have to match
+// the indexed tokens.
+func AddKeywordOverrideFields(prefix string, fields graphql.InputObjectConfigFieldMap) graphql.InputObjectConfigFieldMap {
+	fields["stopwords"] = &graphql.InputObjectFieldConfig{
+		Description: "Stopwords removed from the query, instead of the stopwords of the class",
+		Type: graphql.NewInputObject(graphql.InputObjectConfig{
+			Name: fmt.Sprintf("%sStopwordsInpObj", prefix),
+			Fields: graphql.InputObjectConfigFieldMap{
+				"preset": &graphql.InputObjectFieldConfig{
+					Description: "Pre-existing list of common words, 'none' keeps all stopwords",
+					Type:        graphql.String,
+				},
+				"additions": &graphql.InputObjectFieldConfig{
+					Description: "Stopwords added to the preset",
+					Type:        graphql.NewList(graphql.String),
+				},
+				"removals": &graphql.InputObjectFieldConfig{
+					Description: "Stopwords removed from the preset",
+					Type:        graphql.NewList(graphql.String),
+				},
+			},
+		}),
+	}
+	fields["searchOperator"] = &graphql.InputObjectFieldConfig{
+		Description: "How many of the query terms a result has to contain",
+		Type: graphql.NewInputObject(graphql.InputObjectConfig{
+			Name: fmt.Sprintf("%sSearchOperatorInpObj", prefix),
+			Fields: graphql.InputObjectConfigFieldMap{
+				"operator": &graphql.InputObjectFieldConfig{
+					Description: "'or' (default) matches any query term, 'and' requires all of them",
+					Type:        graphql.String,
+				},
+				"minimumShouldMatch": &graphql.InputObjectFieldConfig{
+					Description: "Minimum number ('2') or percentage ('75%') of query terms a result has to contain, only with the 'or' operator",
+					Type:        graphql.String,
+				},
+			},
+		}),
+	}
+	return fields
+}
+
+func extractStopwords(source map[string]interface{}) *models.StopwordConfig {
+	stopwordsMap, ok := source["stopwords"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	stopwords := &models.StopwordConfig{}
+	stopwords.Preset, _ = stopwordsMap["preset"].(string)
+	stopwords.Additions = extractStrings(stopwordsMap["additions"])
+	stopwords.Removals = extractStrings(stopwordsMap["removals"])
+	return stopwords
+}
+
+func extractKeywordOperator(source map[string]interface{}) searchparams.KeywordOperator {
+	var operator searchparams.KeywordOperator
+	operatorMap, ok := source["searchOperator"].(map[string]interface{})
+	if !ok {
+		return operator
+	}
+	operator.Operator, _ = operatorMap["operator"].(string)
+	operator.MinimumShouldMatch, _ = operatorMap["minimumShouldMatch"].(string)
+	return operator
+}
+
+func extractStrings(source interface{}) []string {
+	raw, ok := source.([]interface{})
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, len(raw))
+	for _, value := range raw {
+		if s, ok := value.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -44,8 +44,9 @@ func Build(schema *models.Schema, modulesProvider ModulesProvider, authorizer au
 				Description: descriptions.Limit,
 			},
 
-			"nearVector": nearVectorArgument(),
-			"nearObject": nearObjectArgument(),
+			"nearVector":  nearVectorArgument(),
+			"nearObject":  nearObjectArgument(),
+			"collections": collectionsArgument(),
 		},
 	}
 
@@ -115,6 +116,20 @@ func exploreObject() *graphql.Object {
 				return vsr.Dist, nil
 			},
 		},
+
+		"score": &graphql.Field{
+			Name:        "ExploreScore",
+			Description: descriptions.ExploreScore,
+			Type:        graphql.Float,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				vsr, ok := p.Source.(search.Result)
+				if !ok {
+					return nil, fmt.Errorf("unknown type %T in Explore..score resolver", p.Source)
+				}
+
+				return vsr.Score, nil
+			},
+		},
 	}
 
 	getLocalExploreFieldsObject := graphql.ObjectConfig{
@@ -194,3 +209,32 @@ func nearObjectFields() graphql.InputObjectConfigFieldMap {
 		},
 	}
 }
+
+func collectionsArgument() *graphql.ArgumentConfig {
+	return &graphql.ArgumentConfig{
+		Description: descriptions.ExploreCollections,
+		Type: graphql.NewList(graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name: "ExploreCollectionsInpObj",
+				Fields: graphql.InputObjectConfigFieldMap{
+					"name": &graphql.InputObjectFieldConfig{
+						Description: descriptions.ClassName,
+						Type:        graphql.NewNonNull(graphql.String),
+					},
+					"weight": &graphql.InputObjectFieldConfig{
+						Description: "Weight of the results of this collection, defaults to 1",
+						Type:        graphql.Float,
+					},
+					"targetVector": &graphql.InputObjectFieldConfig{
+						Description: "Target vector searched in this collection, instead of the one of the search",
+						Type:        graphql.String,
+					},
+					"tenant": &graphql.InputObjectFieldConfig{
+						Description: descriptions.Tenant,
+						Type:        graphql.String,
+					},
+				},
+			},
+		)),
+	}
+}
@@ -22,6 +22,7 @@ import (
 	restCtx "github.com/weaviate/weaviate/adapters/handlers/rest/context"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/traverser"
@@ -81,7 +82,18 @@ func (r *resolver) resolve(p graphql.ResolveParams) (interface{}, error) {
 func (r *resolver) resolveExplore(p graphql.ResolveParams) (interface{}, error) {
 	principal := restCtx.GetPrincipalFromContext(p.Context)
 
-	err := r.authorizer.Authorize(principal, authorization.READ, authorization.CollectionsData()...)
+	collections := extractCollections(p.Args["collections"])
+	dataResources := authorization.CollectionsData()
+	if len(collections) > 0 {
+		// a federated exploration only reads the collections and tenants it
+		// targets
+		dataResources = make([]string, 0, len(collections))
+		for _, collection := range collections {
+			dataResources = append(dataResources, authorization.ShardsData(collection.Name, collection.Tenant)...)
+		}
+	}
+
+	err := r.authorizer.Authorize(principal, authorization.READ, dataResources...)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +103,7 @@ func (r *resolver) resolveExplore(p graphql.ResolveParams) (interface{}, error)
 		return nil, err
 	}
 
-	params := traverser.ExploreParams{}
+	params := traverser.ExploreParams{Collections: collections}
 
 	if param, ok := p.Args["nearVector"]; ok {
 		extracted, _, err := common_filters.ExtractNearVector(param.(map[string]interface{}), nil)
@@ -131,6 +143,31 @@ func (r *resolver) resolveExplore(p graphql.ResolveParams) (interface{}, error)
 	return resources.resolver.Explore(p.Context, principal, params)
 }
 
+func extractCollections(source interface{}) []traverser.ExploreCollection {
+	raw, ok := source.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	collections := make([]traverser.ExploreCollection, 0, len(raw))
+	for _, value := range raw {
+		collectionMap, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		collection := traverser.ExploreCollection{Weight: 1}
+		name, _ := collectionMap["name"].(string)
+		collection.Name = schema.UppercaseClassName(name)
+		if weight, ok := collectionMap["weight"].(float64); ok {
+			collection.Weight = weight
+		}
+		collection.TargetVector, _ = collectionMap["targetVector"].(string)
+		collection.Tenant, _ = collectionMap["tenant"].(string)
+		collections = append(collections, collection)
+	}
+	return collections
+}
+
 func containsCertaintyProperty(info graphql.ResolveInfo) bool {
 	if len(info.FieldASTs) == 0 {
 		return false
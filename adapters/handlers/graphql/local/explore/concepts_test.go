@@ -432,6 +432,46 @@ func Test_ResolveExplore(t *testing.T) {
 			}},
 		},
 
+		testCase{
+			name: "with nearVector across weighted collections",
+			query: `
+			{
+					Explore(nearVector: {vector: [0, 1, 0.8], targetVectors: ["body"]},
+						collections: [{name: "articles", weight: 2}, {name: "Videos", targetVector: "transcript", tenant: "tenant1"}]) {
+							beacon className distance score
+					}
+			}`,
+			expectedParamsToTraverser: traverser.ExploreParams{
+				NearVector: &searchparams.NearVector{
+					Vectors:       []models.Vector{[]float32{0, 1, 0.8}},
+					TargetVectors: []string{"body"},
+				},
+				Collections: []traverser.ExploreCollection{
+					{Name: "Articles", Weight: 2},
+					{Name: "Videos", Weight: 1, TargetVector: "transcript", Tenant: "tenant1"},
+				},
+			},
+			resolverReturn: []search.Result{
+				{
+					Beacon:    "weaviate://localhost/Articles/some-uuid",
+					ClassName: "Articles",
+					Dist:      0.25,
+					Score:     1.5,
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Explore"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"beacon":    "weaviate://localhost/Articles/some-uuid",
+						"className": "Articles",
+						"distance":  float32(0.25),
+						"score":     float32(1.5),
+					},
+				},
+			}},
+		},
+
 		testCase{
 			name: "with nearVector with optional limit",
 			query: `
@@ -117,6 +117,7 @@ func hybridOperands(classObject *graphql.Object,
 		},
 	}
 	fieldMap = common_filters.AddTargetArgument(fieldMap, prefixName+"hybrid", true)
+	fieldMap = common_filters.AddKeywordOverrideFields(prefixName+"hybrid", fieldMap)
 
 	if os.Getenv("ENABLE_EXPERIMENTAL_HYBRID_OPERANDS") != "" {
 		fieldMap["operands"] = &graphql.InputObjectFieldConfig{
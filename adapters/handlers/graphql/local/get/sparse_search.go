@@ -15,6 +15,8 @@ import (
 	"fmt"
 
 	"github.com/tailor-inc/graphql"
+
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
 )
 
 func bm25Argument(className string) *graphql.ArgumentConfig {
@@ -30,7 +32,7 @@ func bm25Argument(className string) *graphql.ArgumentConfig {
 }
 
 func bm25Fields(prefix string) graphql.InputObjectConfigFieldMap {
-	return graphql.InputObjectConfigFieldMap{
+	return common_filters.AddKeywordOverrideFields(prefix, graphql.InputObjectConfigFieldMap{
 		"query": &graphql.InputObjectFieldConfig{
 			Description: "The query to search for",
 			Type:        graphql.String,
@@ -39,5 +41,5 @@ func bm25Fields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: "The properties to search in",
 			Type:        graphql.NewList(graphql.String),
 		},
-	}
+	})
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
 	authErrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/qos"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
@@ -61,7 +62,7 @@ func CreateGRPCServer(state *state.State, options ...grpc.ServerOption) *grpc.Se
 
 	var interceptors []grpc.UnaryServerInterceptor
 
-	interceptors = append(interceptors, makeAuthInterceptor())
+	interceptors = append(interceptors, makeAuthInterceptor(), makeRateLimitInterceptor())
 
 	// If sentry is enabled add automatic spans on gRPC requests
 	if state.ServerConfig.Config.Sentry.Enabled {
@@ -88,6 +89,7 @@ func CreateGRPCServer(state *state.State, options ...grpc.ServerOption) *grpc.Se
 		state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
 		state.SchemaManager,
 		state.BatchManager,
+		state.QueryTemplates,
 		&state.ServerConfig.Config,
 		state.Authorizer,
 		state.Logger,
@@ -149,6 +151,23 @@ func makeAuthInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// makeRateLimitInterceptor reports queries that weren't admitted by their QoS
+// class, because its queue is full or they waited too long, as
+// ResourceExhausted
+func makeRateLimitInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		resp, err := handler(ctx, req)
+
+		if qos.IsRejected(err) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+
+		return resp, err
+	}
+}
+
 func StartAndListen(s *grpc.Server, state *state.State) error {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d",
 		state.ServerConfig.Config.GRPC.Port))
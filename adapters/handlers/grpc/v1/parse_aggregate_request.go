@@ -47,13 +47,24 @@ func (p *AggregateParser) Aggregate(req *pb.AggregateRequest) (*aggregation.Para
 	params.Tenant = req.Tenant
 
 	if req.ObjectLimit != nil {
+		if *req.ObjectLimit == 0 {
+			return nil, fmt.Errorf("object_limit must be a positive integer")
+		}
 		objectLimit := int(*req.ObjectLimit)
 		params.ObjectLimit = &objectLimit
 	}
 
 	if req.GroupBy != nil {
+		if req.GroupBy.Property == "" {
+			return nil, fmt.Errorf("group_by: property is required")
+		}
+		// the collection of the path defaults to the aggregated one
+		collection := req.GroupBy.Collection
+		if collection == "" {
+			collection = class.Class
+		}
 		params.GroupBy = &filters.Path{
-			Class:    schema.ClassName(req.GroupBy.Collection),
+			Class:    schema.ClassName(collection),
 			Property: schema.PropertyName(req.GroupBy.Property),
 		}
 	}
@@ -67,6 +78,10 @@ func (p *AggregateParser) Aggregate(req *pb.AggregateRequest) (*aggregation.Para
 	if len(req.Aggregations) > 0 {
 		properties := make([]aggregation.ParamProperty, len(req.Aggregations))
 		for i := range req.Aggregations {
+			if req.Aggregations[i].GetAggregation() == nil {
+				return nil, fmt.Errorf("aggregation for property %q: no aggregation type set",
+					req.Aggregations[i].Property)
+			}
 			properties[i] = aggregation.ParamProperty{
 				Name:        schema.PropertyName(req.Aggregations[i].Property),
 				Aggregators: parseAggregations(req.Aggregations[i]),
@@ -324,6 +339,12 @@ func (p *AggregateParser) Aggregate(req *pb.AggregateRequest) (*aggregation.Para
 		return nil, fmt.Errorf("unrecognized search: %T", req.GetSearch())
 	}
 
+	// same as in the GraphQL API, the object limit restricts the number of
+	// objects matched by a search, it has no meaning without one
+	if params.ObjectLimit != nil && !validateObjectLimitUsage(params) {
+		return nil, fmt.Errorf("object_limit can only be used with a near<Media> or hybrid search")
+	}
+
 	return params, nil
 }
 
@@ -396,7 +417,7 @@ func parseAggregations(in *pb.AggregateRequest_Aggregation) []aggregation.Aggreg
 				limit := int(*a.Text.TopOccurencesLimit)
 				aggregators = append(aggregators, aggregation.NewTopOccurrencesAggregator(&limit))
 			} else {
-				aggregators = append(aggregators, aggregation.TotalTrueAggregator)
+				aggregators = append(aggregators, aggregation.NewTopOccurrencesAggregator(nil))
 			}
 		}
 		return aggregators
@@ -456,6 +477,13 @@ func parseAggregations(in *pb.AggregateRequest_Aggregation) []aggregation.Aggreg
 	}
 }
 
+func validateObjectLimitUsage(params *aggregation.Params) bool {
+	return params.NearObject != nil ||
+		params.NearVector != nil ||
+		len(params.ModuleParams) > 0 ||
+		params.Hybrid != nil
+}
+
 func extractTargetVectorsForAggregate(req *pb.AggregateRequest, class *models.Class) ([]string, *dto.TargetCombination, bool, error) {
 	var targetVectors []string
 	var targets *pb.Targets
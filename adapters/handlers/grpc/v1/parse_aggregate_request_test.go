@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
@@ -130,6 +131,84 @@ func TestGRPCAggregateRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "top occurrences without limit",
+			req: &pb.AggregateRequest{
+				Collection: mixedVectorsClass,
+				Aggregations: []*pb.AggregateRequest_Aggregation{
+					{
+						Property: "first",
+						Aggregation: &pb.AggregateRequest_Aggregation_Text_{
+							Text: &pb.AggregateRequest_Aggregation_Text{
+								TopOccurences: true,
+							},
+						},
+					},
+				},
+			},
+			out: &aggregation.Params{
+				ClassName: schema.ClassName(mixedVectorsClass),
+				Properties: []aggregation.ParamProperty{
+					{
+						Name:        "first",
+						Aggregators: []aggregation.Aggregator{aggregation.NewTopOccurrencesAggregator(nil)},
+					},
+				},
+			},
+		},
+		{
+			name: "group by defaults to the aggregated collection",
+			req: &pb.AggregateRequest{
+				Collection:   mixedVectorsClass,
+				ObjectsCount: true,
+				GroupBy:      &pb.AggregateRequest_GroupBy{Property: "first"},
+				Limit:        ptr(uint32(5)),
+			},
+			out: &aggregation.Params{
+				ClassName:        schema.ClassName(mixedVectorsClass),
+				IncludeMetaCount: true,
+				GroupBy: &filters.Path{
+					Class:    schema.ClassName(mixedVectorsClass),
+					Property: "first",
+				},
+				Limit: ptr(5),
+			},
+		},
+		{
+			name: "group by without property",
+			req: &pb.AggregateRequest{
+				Collection: mixedVectorsClass,
+				GroupBy:    &pb.AggregateRequest_GroupBy{Collection: mixedVectorsClass},
+			},
+			error: true,
+		},
+		{
+			name: "aggregation without type",
+			req: &pb.AggregateRequest{
+				Collection:   mixedVectorsClass,
+				Aggregations: []*pb.AggregateRequest_Aggregation{{Property: "first"}},
+			},
+			error: true,
+		},
+		{
+			name: "object limit without search",
+			req: &pb.AggregateRequest{
+				Collection:  mixedVectorsClass,
+				ObjectLimit: ptr(uint32(10)),
+			},
+			error: true,
+		},
+		{
+			name: "object limit of zero",
+			req: &pb.AggregateRequest{
+				Collection:  mixedVectorsClass,
+				ObjectLimit: ptr(uint32(0)),
+				Search: &pb.AggregateRequest_NearObject{
+					NearObject: &pb.NearObject{Id: string(UUID1)},
+				},
+			},
+			error: true,
+		},
 	}
 
 	parser := NewAggregateParser(getClass)
@@ -104,7 +104,14 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 	}
 
 	if bm25 := req.Bm25Search; bm25 != nil {
-		out.KeywordRanking = &searchparams.KeywordRanking{Query: bm25.Query, Properties: schema.LowercaseFirstLetterOfStrings(bm25.Properties), Type: "bm25", AdditionalExplanations: out.AdditionalProperties.ExplainScore}
+		searchOperator, err := extractSearchOperator(bm25.SearchOperator)
+		if err != nil {
+			return dto.GetParams{}, fmt.Errorf("bm25: %w", err)
+		}
+		out.KeywordRanking = &searchparams.KeywordRanking{
+			Query: bm25.Query, Properties: schema.LowercaseFirstLetterOfStrings(bm25.Properties), Type: "bm25", AdditionalExplanations: out.AdditionalProperties.ExplainScore,
+			Stopwords: extractStopwords(bm25.Stopwords), SearchOperator: searchOperator,
+		}
 	}
 
 	if nv := req.NearVector; nv != nil {
@@ -279,6 +286,11 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 		}
 		nearVec := req.HybridSearch.NearVector
 
+		searchOperator, err := extractSearchOperator(hs.SearchOperator)
+		if err != nil {
+			return dto.GetParams{}, fmt.Errorf("hybrid: %w", err)
+		}
+
 		out.HybridSearch = &searchparams.HybridSearch{
 			Query:           hs.Query,
 			Properties:      schema.LowercaseFirstLetterOfStrings(hs.Properties),
@@ -288,6 +300,8 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 			TargetVectors:   targetVectors,
 			Distance:        distance,
 			WithDistance:    withDistance,
+			Stopwords:       extractStopwords(hs.Stopwords),
+			SearchOperator:  searchOperator,
 		}
 
 		if nearVec != nil {
@@ -592,6 +606,30 @@ func extractWeights(in *pb.Targets, weights []float32) error {
 	}
 }
 
+func extractStopwords(in *pb.StopwordConfig) *models.StopwordConfig {
+	if in == nil {
+		return nil
+	}
+	return &models.StopwordConfig{Preset: in.Preset, Additions: in.Additions, Removals: in.Removals}
+}
+
+func extractSearchOperator(in *pb.SearchOperatorOptions) (searchparams.KeywordOperator, error) {
+	if in == nil {
+		return searchparams.KeywordOperator{}, nil
+	}
+	out := searchparams.KeywordOperator{MinimumShouldMatch: in.MinimumShouldMatch}
+	switch in.Operator {
+	case pb.SearchOperatorOptions_OPERATOR_UNSPECIFIED:
+	case pb.SearchOperatorOptions_OPERATOR_OR:
+		out.Operator = searchparams.KeywordOperatorOr
+	case pb.SearchOperatorOptions_OPERATOR_AND:
+		out.Operator = searchparams.KeywordOperatorAnd
+	default:
+		return searchparams.KeywordOperator{}, fmt.Errorf("unknown search operator %v", in.Operator)
+	}
+	return out, nil
+}
+
 func extractSorting(sortIn []*pb.SortBy) []filters.Sort {
 	sortOut := make([]filters.Sort, len(sortIn))
 	for i := range sortIn {
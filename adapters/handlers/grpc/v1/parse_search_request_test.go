@@ -929,6 +929,27 @@ func TestGRPCSearchRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "hybrid with stopwords and search operator",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true, Certainty: false},
+				HybridSearch: &pb.Hybrid{
+					Query: "query", FusionType: pb.Hybrid_FUSION_TYPE_RELATIVE_SCORE,
+					Stopwords:      &pb.StopwordConfig{Preset: "en", Removals: []string{"a"}},
+					SearchOperator: &pb.SearchOperatorOptions{Operator: pb.SearchOperatorOptions_OPERATOR_AND},
+				},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination, HybridSearch: &searchparams.HybridSearch{
+					Query: "query", FusionAlgorithm: common_filters.HybridRelativeScoreFusion,
+					Stopwords:      &models.StopwordConfig{Preset: "en", Removals: []string{"a"}},
+					SearchOperator: searchparams.KeywordOperator{Operator: searchparams.KeywordOperatorAnd},
+				},
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+			},
+			error: false,
+		},
 		{
 			name: "hybrid default",
 			req: &pb.SearchRequest{
@@ -957,6 +978,57 @@ func TestGRPCSearchRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "bm25 with stopwords",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Bm25Search: &pb.BM25{
+					Query: "query", Properties: []string{"name"},
+					Stopwords: &pb.StopwordConfig{Preset: "none", Additions: []string{"query"}},
+				},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				KeywordRanking: &searchparams.KeywordRanking{
+					Query: "query", Properties: []string{"name"}, Type: "bm25",
+					Stopwords: &models.StopwordConfig{Preset: "none", Additions: []string{"query"}},
+				},
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+			},
+			error: false,
+		},
+		{
+			name: "bm25 with search operator",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Bm25Search: &pb.BM25{
+					Query: "query", Properties: []string{"name"},
+					SearchOperator: &pb.SearchOperatorOptions{Operator: pb.SearchOperatorOptions_OPERATOR_OR, MinimumShouldMatch: "75%"},
+				},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				KeywordRanking: &searchparams.KeywordRanking{
+					Query: "query", Properties: []string{"name"}, Type: "bm25",
+					SearchOperator: searchparams.KeywordOperator{Operator: searchparams.KeywordOperatorOr, MinimumShouldMatch: "75%"},
+				},
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+			},
+			error: false,
+		},
+		{
+			name: "bm25 with unknown search operator",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Bm25Search: &pb.BM25{
+					Query: "query", SearchOperator: &pb.SearchOperatorOptions{Operator: 7},
+				},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
 		{
 			name: "bm25 groupby",
 			req: &pb.SearchRequest{
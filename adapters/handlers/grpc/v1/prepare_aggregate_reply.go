@@ -13,6 +13,8 @@ package v1
 
 import (
 	"fmt"
+	"slices"
+	"sort"
 
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -21,10 +23,18 @@ import (
 
 type AggregateReplier struct {
 	authorizedGetDataTypeOfProp func(string) (string, error)
+	// properties are the requested aggregations, the reply lists them in
+	// the same order
+	properties []aggregation.ParamProperty
 }
 
 func NewAggregateReplier(authorizedGetClass classGetterWithAuthzFunc, params *aggregation.Params) *AggregateReplier {
+	var properties []aggregation.ParamProperty
+	if params != nil {
+		properties = params.Properties
+	}
 	return &AggregateReplier{
+		properties: properties,
 		authorizedGetDataTypeOfProp: func(propName string) (string, error) {
 			class, err := authorizedGetClass(string(params.ClassName))
 			if err != nil {
@@ -141,9 +151,9 @@ func (r *AggregateReplier) parseAggregateGroupedBy(in *aggregation.GroupedBy) (*
 func (r *AggregateReplier) parseAggregatedProperties(in map[string]aggregation.Property) (*pb.AggregateReply_Aggregations, error) {
 	var aggregations *pb.AggregateReply_Aggregations
 	if len(in) > 0 {
-		propertyAggregations := []*pb.AggregateReply_Aggregations_Aggregation{}
-		for name, property := range in {
-			aggregationResult, err := r.parseAggregationResult(name, property)
+		propertyAggregations := make([]*pb.AggregateReply_Aggregations_Aggregation, 0, len(in))
+		for _, name := range r.propertyNames(in) {
+			aggregationResult, err := r.parseAggregationResult(name, in[name])
 			if err != nil {
 				return nil, fmt.Errorf("parse aggregation property: %w", err)
 			}
@@ -156,6 +166,38 @@ func (r *AggregateReplier) parseAggregatedProperties(in map[string]aggregation.P
 	return aggregations, nil
 }
 
+// propertyNames returns the names of the aggregated properties in the order
+// in which they were requested. Properties which were not requested are
+// appended in alphabetical order, so the reply is always deterministic.
+func (r *AggregateReplier) propertyNames(in map[string]aggregation.Property) []string {
+	names := make([]string, 0, len(in))
+	for _, prop := range r.properties {
+		if _, ok := in[string(prop.Name)]; ok && !slices.Contains(names, string(prop.Name)) {
+			names = append(names, string(prop.Name))
+		}
+	}
+
+	var rest []string
+	for name := range in {
+		if !slices.Contains(names, name) {
+			rest = append(rest, name)
+		}
+	}
+	sort.Strings(rest)
+	return append(names, rest...)
+}
+
+// aggregators returns the aggregators requested for the property. It returns
+// nil if the property wasn't requested explicitly.
+func (r *AggregateReplier) aggregators(propertyName string) []aggregation.Aggregator {
+	for _, prop := range r.properties {
+		if string(prop.Name) == propertyName {
+			return prop.Aggregators
+		}
+	}
+	return nil
+}
+
 func (r *AggregateReplier) parseAggregationResult(propertyName string, property aggregation.Property) (*pb.AggregateReply_Aggregations_Aggregation, error) {
 	switch property.Type {
 	case aggregation.PropertyTypeNumerical:
@@ -190,7 +232,8 @@ func (r *AggregateReplier) parseAggregationResult(propertyName string, property
 			Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Text_{Text: textAggregation},
 		}, nil
 	case aggregation.PropertyTypeBoolean:
-		booleanAggregation := parseBooleanAggregation(property.SchemaType, property.BooleanAggregation)
+		booleanAggregation := parseBooleanAggregation(property.SchemaType, property.BooleanAggregation,
+			r.aggregators(propertyName))
 		return &pb.AggregateReply_Aggregations_Aggregation{
 			Property:    propertyName,
 			Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Boolean_{Boolean: booleanAggregation},
@@ -302,16 +345,33 @@ func parseTextAggregation(schemaType string, in aggregation.Text) *pb.AggregateR
 	}
 }
 
-func parseBooleanAggregation(schemaType string, in aggregation.Boolean) *pb.AggregateReply_Aggregations_Aggregation_Boolean {
-	// TODO: check if it was requested
-	return &pb.AggregateReply_Aggregations_Aggregation_Boolean{
-		Count:           ptInt64(in.Count),
-		Type:            &schemaType,
-		TotalTrue:       ptInt64(in.TotalTrue),
-		TotalFalse:      ptInt64(in.TotalFalse),
-		PercentageTrue:  &in.PercentageTrue,
-		PercentageFalse: &in.PercentageFalse,
+// parseBooleanAggregation only sets the requested aggregations, as the zero
+// values of a boolean aggregation can't be told apart from real results. If
+// the requested aggregators are unknown, all of them are set.
+func parseBooleanAggregation(schemaType string, in aggregation.Boolean,
+	aggregators []aggregation.Aggregator,
+) *pb.AggregateReply_Aggregations_Aggregation_Boolean {
+	requested := func(agg aggregation.Aggregator) bool {
+		return len(aggregators) == 0 || slices.Contains(aggregators, agg)
+	}
+
+	out := &pb.AggregateReply_Aggregations_Aggregation_Boolean{Type: &schemaType}
+	if requested(aggregation.CountAggregator) {
+		out.Count = ptInt64(in.Count)
+	}
+	if requested(aggregation.TotalTrueAggregator) {
+		out.TotalTrue = ptInt64(in.TotalTrue)
+	}
+	if requested(aggregation.TotalFalseAggregator) {
+		out.TotalFalse = ptInt64(in.TotalFalse)
+	}
+	if requested(aggregation.PercentageTrueAggregator) {
+		out.PercentageTrue = &in.PercentageTrue
+	}
+	if requested(aggregation.PercentageFalseAggregator) {
+		out.PercentageFalse = &in.PercentageFalse
 	}
+	return out
 }
 
 func parseDateAggregation(schemaType string, in map[string]interface{}) (*pb.AggregateReply_Aggregations_Aggregation_Date, error) {
@@ -23,6 +23,7 @@ import (
 func TestGRPCAggregateReply(t *testing.T) {
 	tests := []struct {
 		name      string
+		params    *aggregation.Params
 		res       interface{}
 		outRes    *pb.AggregateReply
 		wantError error
@@ -48,10 +49,71 @@ func TestGRPCAggregateReply(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "aggregations in requested order with requested boolean values",
+			params: &aggregation.Params{
+				Properties: []aggregation.ParamProperty{
+					{Name: "title", Aggregators: []aggregation.Aggregator{aggregation.CountAggregator}},
+					{Name: "active", Aggregators: []aggregation.Aggregator{aggregation.TotalTrueAggregator}},
+				},
+			},
+			res: &aggregation.Result{
+				Groups: []aggregation.Group{
+					{
+						Count: 3,
+						Properties: map[string]aggregation.Property{
+							"active": {
+								Type:               aggregation.PropertyTypeBoolean,
+								SchemaType:         "boolean",
+								BooleanAggregation: aggregation.Boolean{Count: 3, TotalTrue: 2, TotalFalse: 1},
+							},
+							"title": {
+								Type:            aggregation.PropertyTypeText,
+								SchemaType:      "text",
+								TextAggregation: aggregation.Text{Count: 3},
+							},
+						},
+					},
+				},
+			},
+			outRes: &pb.AggregateReply{
+				Result: &pb.AggregateReply_GroupedResults{
+					GroupedResults: &pb.AggregateReply_Grouped{
+						Groups: []*pb.AggregateReply_Group{
+							{
+								ObjectsCount: ptInt64(3),
+								Aggregations: &pb.AggregateReply_Aggregations{
+									Aggregations: []*pb.AggregateReply_Aggregations_Aggregation{
+										{
+											Property: "title",
+											Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Text_{
+												Text: &pb.AggregateReply_Aggregations_Aggregation_Text{
+													Count: ptInt64(3),
+													Type:  ptr("text"),
+												},
+											},
+										},
+										{
+											Property: "active",
+											Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Boolean_{
+												Boolean: &pb.AggregateReply_Aggregations_Aggregation_Boolean{
+													Type:      ptr("boolean"),
+													TotalTrue: ptInt64(2),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			replier := NewAggregateReplier(nil, nil)
+			replier := NewAggregateReplier(nil, tt.params)
 			result, err := replier.Aggregate(tt.res, true)
 			if tt.wantError != nil {
 				require.Error(t, err)
@@ -35,6 +35,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	"github.com/weaviate/weaviate/usecases/querytemplates"
 	schemaManager "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/traverser"
 )
@@ -46,6 +47,7 @@ type Service struct {
 	allowAnonymousAccess bool
 	schemaManager        *schemaManager.Manager
 	batchManager         *objects.BatchManager
+	templates            *querytemplates.Templates
 	config               *config.Config
 	authorizer           authorization.Authorizer
 	logger               logrus.FieldLogger
@@ -53,7 +55,8 @@ type Service struct {
 
 func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
 	allowAnonymousAccess bool, schemaManager *schemaManager.Manager,
-	batchManager *objects.BatchManager, config *config.Config, authorization authorization.Authorizer,
+	batchManager *objects.BatchManager, templates *querytemplates.Templates,
+	config *config.Config, authorization authorization.Authorizer,
 	logger logrus.FieldLogger,
 ) *Service {
 	return &Service{
@@ -62,6 +65,7 @@ func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
 		allowAnonymousAccess: allowAnonymousAccess,
 		schemaManager:        schemaManager,
 		batchManager:         batchManager,
+		templates:            templates,
 		config:               config,
 		logger:               logger,
 		authorizer:           authorization,
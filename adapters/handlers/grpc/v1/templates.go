@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/querytemplates"
+)
+
+// ValidateSearchTemplate checks that search is a SearchRequest in its JSON
+// representation, see querytemplates.SearchValidator
+func ValidateSearchTemplate(search json.RawMessage) error {
+	return protojson.Unmarshal(search, &pb.SearchRequest{})
+}
+
+func (s *Service) ExecuteTemplate(ctx context.Context, req *pb.ExecuteTemplateRequest) (*pb.SearchReply, error) {
+	var result *pb.SearchReply
+	var errInner error
+
+	if err := enterrors.GoWrapperWithBlock(func() {
+		result, errInner = s.executeTemplate(ctx, req)
+	}, s.logger); err != nil {
+		return nil, err
+	}
+
+	return result, errInner
+}
+
+// executeTemplate resolves the search request of a query template and runs
+// it like any other search, with the permissions of the caller
+func (s *Service) executeTemplate(ctx context.Context, req *pb.ExecuteTemplateRequest) (*pb.SearchReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	template, err := s.templates.Get(principal, req.Name, req.GetVersion())
+	if err != nil {
+		switch {
+		case errors.Is(err, querytemplates.ErrNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, querytemplates.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, querytemplates.ErrVersion):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, err
+		}
+	}
+	if len(template.Search) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"query template %q holds a GraphQL query, execute it through the REST API", template.Name)
+	}
+
+	searchReq, err := resolveSearchTemplate(template, req.GetVariables().AsMap())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return s.search(ctx, searchReq)
+}
+
+func resolveSearchTemplate(template command.QueryTemplate, variables map[string]interface{}) (*pb.SearchRequest, error) {
+	search, err := querytemplates.ResolveSearch(template, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pb.SearchRequest{}
+	if err := protojson.Unmarshal(search, req); err != nil {
+		return nil, fmt.Errorf("search of query template %q: %w", template.Name, err)
+	}
+	return req, nil
+}
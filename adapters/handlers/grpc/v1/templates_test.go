@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+func TestSearchTemplates(t *testing.T) {
+	template := command.QueryTemplate{
+		Name: "productSearch",
+		Search: json.RawMessage(`{
+			"collection": "Product",
+			"limit": "$limit",
+			"hybrid_search": {"query": "$text", "alpha": 0.5},
+			"properties": {"non_ref_properties": ["name"]}
+		}`),
+	}
+
+	t.Run("validate", func(t *testing.T) {
+		assert.Nil(t, ValidateSearchTemplate(json.RawMessage(`{"collection": "Product", "limit": 10}`)))
+		assert.NotNil(t, ValidateSearchTemplate(json.RawMessage(`{"collection": "Product", "limit": "ten"}`)))
+		assert.NotNil(t, ValidateSearchTemplate(json.RawMessage(`{"collection": "Product", "top_k": 10}`)))
+	})
+
+	t.Run("resolve", func(t *testing.T) {
+		req, err := resolveSearchTemplate(template, map[string]interface{}{
+			"limit": float64(5),
+			"text":  "shoes",
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "Product", req.Collection)
+		assert.Equal(t, uint32(5), req.Limit)
+		require.NotNil(t, req.HybridSearch)
+		assert.Equal(t, "shoes", req.HybridSearch.Query)
+		assert.Equal(t, float32(0.5), req.HybridSearch.Alpha)
+		assert.Equal(t, []string{"name"}, req.Properties.NonRefProperties)
+	})
+
+	t.Run("variable of the wrong type", func(t *testing.T) {
+		_, err := resolveSearchTemplate(template, map[string]interface{}{
+			"limit": "five",
+			"text":  "shoes",
+		})
+		assert.ErrorContains(t, err, `search of query template "productSearch"`)
+	})
+
+	t.Run("missing variable", func(t *testing.T) {
+		_, err := resolveSearchTemplate(template, map[string]interface{}{"limit": float64(5)})
+		assert.ErrorContains(t, err, `variable "text" of query template "productSearch" is not set`)
+	})
+}
@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package authz
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	cerrors "github.com/weaviate/weaviate/adapters/handlers/rest/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// RequireAuthentication rejects anonymous requests before they reach handle.
+// Roles and users can't be managed anonymously, even if anonymous access is
+// enabled and the anonymous group has been granted read permissions.
+func RequireAuthentication[P any](handle func(P, *models.Principal) middleware.Responder,
+) func(P, *models.Principal) middleware.Responder {
+	return func(params P, principal *models.Principal) middleware.Responder {
+		if principal == nil {
+			return middleware.ResponderFunc(func(rw http.ResponseWriter, p runtime.Producer) {
+				rw.WriteHeader(http.StatusUnauthorized)
+				p.Produce(rw, cerrors.ErrPayloadFromSingleErr(
+					fmt.Errorf("anonymous access is not allowed, please authenticate")))
+			})
+		}
+		return handle(params, principal)
+	}
+}
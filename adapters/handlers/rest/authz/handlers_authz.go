@@ -75,23 +75,23 @@ func SetupHandlers(api *operations.WeaviateAPI, controller ControllerAndGetUsers
 	}
 
 	// rbac role handlers
-	api.AuthzCreateRoleHandler = authz.CreateRoleHandlerFunc(h.createRole)
-	api.AuthzGetRolesHandler = authz.GetRolesHandlerFunc(h.getRoles)
-	api.AuthzGetRoleHandler = authz.GetRoleHandlerFunc(h.getRole)
-	api.AuthzDeleteRoleHandler = authz.DeleteRoleHandlerFunc(h.deleteRole)
-	api.AuthzAddPermissionsHandler = authz.AddPermissionsHandlerFunc(h.addPermissions)
-	api.AuthzRemovePermissionsHandler = authz.RemovePermissionsHandlerFunc(h.removePermissions)
-	api.AuthzHasPermissionHandler = authz.HasPermissionHandlerFunc(h.hasPermission)
+	api.AuthzCreateRoleHandler = authz.CreateRoleHandlerFunc(RequireAuthentication(h.createRole))
+	api.AuthzGetRolesHandler = authz.GetRolesHandlerFunc(RequireAuthentication(h.getRoles))
+	api.AuthzGetRoleHandler = authz.GetRoleHandlerFunc(RequireAuthentication(h.getRole))
+	api.AuthzDeleteRoleHandler = authz.DeleteRoleHandlerFunc(RequireAuthentication(h.deleteRole))
+	api.AuthzAddPermissionsHandler = authz.AddPermissionsHandlerFunc(RequireAuthentication(h.addPermissions))
+	api.AuthzRemovePermissionsHandler = authz.RemovePermissionsHandlerFunc(RequireAuthentication(h.removePermissions))
+	api.AuthzHasPermissionHandler = authz.HasPermissionHandlerFunc(RequireAuthentication(h.hasPermission))
 
 	// rbac users handlers
-	api.AuthzGetRolesForUserHandler = authz.GetRolesForUserHandlerFunc(h.getRolesForUser)
-	api.AuthzGetUsersForRoleHandler = authz.GetUsersForRoleHandlerFunc(h.getUsersForRole)
-	api.AuthzGetUsersForRoleDeprecatedHandler = authz.GetUsersForRoleDeprecatedHandlerFunc(h.getUsersForRoleDeprecated)
-	api.AuthzAssignRoleToUserHandler = authz.AssignRoleToUserHandlerFunc(h.assignRoleToUser)
-	api.AuthzRevokeRoleFromUserHandler = authz.RevokeRoleFromUserHandlerFunc(h.revokeRoleFromUser)
-	api.AuthzAssignRoleToGroupHandler = authz.AssignRoleToGroupHandlerFunc(h.assignRoleToGroup)
-	api.AuthzRevokeRoleFromGroupHandler = authz.RevokeRoleFromGroupHandlerFunc(h.revokeRoleFromGroup)
-	api.AuthzGetRolesForUserDeprecatedHandler = authz.GetRolesForUserDeprecatedHandlerFunc(h.getRolesForUserDeprecated)
+	api.AuthzGetRolesForUserHandler = authz.GetRolesForUserHandlerFunc(RequireAuthentication(h.getRolesForUser))
+	api.AuthzGetUsersForRoleHandler = authz.GetUsersForRoleHandlerFunc(RequireAuthentication(h.getUsersForRole))
+	api.AuthzGetUsersForRoleDeprecatedHandler = authz.GetUsersForRoleDeprecatedHandlerFunc(RequireAuthentication(h.getUsersForRoleDeprecated))
+	api.AuthzAssignRoleToUserHandler = authz.AssignRoleToUserHandlerFunc(RequireAuthentication(h.assignRoleToUser))
+	api.AuthzRevokeRoleFromUserHandler = authz.RevokeRoleFromUserHandlerFunc(RequireAuthentication(h.revokeRoleFromUser))
+	api.AuthzAssignRoleToGroupHandler = authz.AssignRoleToGroupHandlerFunc(RequireAuthentication(h.assignRoleToGroup))
+	api.AuthzRevokeRoleFromGroupHandler = authz.RevokeRoleFromGroupHandlerFunc(RequireAuthentication(h.revokeRoleFromGroup))
+	api.AuthzGetRolesForUserDeprecatedHandler = authz.GetRolesForUserDeprecatedHandlerFunc(RequireAuthentication(h.getRolesForUserDeprecated))
 }
 
 func (h *authZHandlers) authorizeRoleScopes(principal *models.Principal, originalVerb string, policies []authorization.Policy, roleName string) error {
@@ -508,7 +508,7 @@ func (h *authZHandlers) assignRoleToGroup(params authz.AssignRoleToGroupParams,
 		return authz.NewAssignRoleToGroupNotFound()
 	}
 
-	if err := h.controller.AddRolesForUser(conv.PrefixGroupName(params.ID), params.Body.Roles); err != nil {
+	if err := h.controller.AddRolesForUser(conv.GroupSubject(params.ID), params.Body.Roles); err != nil {
 		return authz.NewAssignRoleToGroupInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("AddRolesForUser: %w", err)))
 	}
 
@@ -859,7 +859,7 @@ func (h *authZHandlers) revokeRoleFromGroup(params authz.RevokeRoleFromGroupPara
 		return authz.NewRevokeRoleFromGroupNotFound()
 	}
 
-	if err := h.controller.RevokeRolesForUser(conv.PrefixGroupName(params.ID), params.Body.Roles...); err != nil {
+	if err := h.controller.RevokeRolesForUser(conv.GroupSubject(params.ID), params.Body.Roles...); err != nil {
 		return authz.NewRevokeRoleFromGroupInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(fmt.Errorf("RevokeRolesForUser: %w", err)))
 	}
 
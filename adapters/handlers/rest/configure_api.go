@@ -14,6 +14,7 @@ package rest
 import (
 	"context"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -47,6 +48,7 @@ import (
 
 	"github.com/weaviate/fgprof"
 	"github.com/weaviate/weaviate/adapters/clients"
+	grpcv1 "github.com/weaviate/weaviate/adapters/handlers/grpc/v1"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/authz"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/clusterapi"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
@@ -93,6 +95,8 @@ import (
 	modmulti2vecnvidia "github.com/weaviate/weaviate/modules/multi2vec-nvidia"
 	modmulti2vecvoyageai "github.com/weaviate/weaviate/modules/multi2vec-voyageai"
 	modner "github.com/weaviate/weaviate/modules/ner-transformers"
+	modsloadazure "github.com/weaviate/weaviate/modules/offload-azure"
+	modsloadgcs "github.com/weaviate/weaviate/modules/offload-gcs"
 	modsloads3 "github.com/weaviate/weaviate/modules/offload-s3"
 	modqnaopenai "github.com/weaviate/weaviate/modules/qna-openai"
 	modqna "github.com/weaviate/weaviate/modules/qna-transformers"
@@ -125,6 +129,7 @@ import (
 	modweaviateembed "github.com/weaviate/weaviate/modules/text2vec-weaviate"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
 	"github.com/weaviate/weaviate/usecases/backup"
+	"github.com/weaviate/weaviate/usecases/benchmark"
 	"github.com/weaviate/weaviate/usecases/build"
 	"github.com/weaviate/weaviate/usecases/classification"
 	"github.com/weaviate/weaviate/usecases/cluster"
@@ -134,6 +139,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/querytemplates"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema"
@@ -141,6 +147,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"github.com/weaviate/weaviate/usecases/telemetry"
 	"github.com/weaviate/weaviate/usecases/traverser"
+	"github.com/weaviate/weaviate/usecases/usage"
 )
 
 const MinimumRequiredContextionaryVersion = "1.0.2"
@@ -205,17 +212,79 @@ func calcCPUs(cpuString string) (int, error) {
 	return cores, nil
 }
 
+// AppStateOptions controls the process-wide side effects of NewAppState
+type AppStateOptions struct {
+	// Embedded skips everything that is only needed to serve a node over the
+	// network or that registers process-wide state: the cluster API, the
+	// debug and profiling listeners and the default HTTP mux. Monitoring and
+	// runtime overrides register global metrics and are rejected, so that
+	// several embedded instances can run in the same process.
+	Embedded bool
+
+	// Config is used instead of the configuration from the config file, the
+	// environment and the command line flags. It is validated like a loaded
+	// configuration.
+	Config *config.Config
+}
+
+// MakeAppState creates all components of a node. The process is terminated
+// if any of them fails to start.
 func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *state.State {
+	appState, err := NewAppState(ctx, options, AppStateOptions{})
+	if err != nil {
+		logger().WithField("action", "startup").WithError(err).Fatal("startup failed")
+	}
+	return appState
+}
+
+// NewAppState creates all components of a node like MakeAppState, but
+// returns an error instead of terminating the process.
+func NewAppState(ctx context.Context, options *swag.CommandLineOptionsGroup,
+	opts AppStateOptions,
+) (_ *state.State, err error) {
 	build.Version = ParseVersionFromSwaggerSpec() // Version is always static and loaded from swagger spec.
 
 	// config.ServerVersion is deprecated: It's there to be backward compatible
 	// use build.Version instead.
 	config.ServerVersion = build.Version
 
-	appState := startupRoutine(ctx, options)
+	appState, err := startupRoutine(ctx, options, opts.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	// if a later step fails, everything started so far is torn down again,
+	// so that a failed start doesn't leave listeners or open files behind in
+	// a process that keeps running, like the host of an embedded node
+	var metaStoreOpening context.Context
+	defer func() {
+		if err == nil {
+			return
+		}
+		if metaStoreOpening != nil {
+			// the meta store loads the db in the background, wait for it to
+			// finish so that the db is closed along with it
+			<-metaStoreOpening.Done()
+		}
+		if closeErr := CloseAppState(context.Background(), appState); closeErr != nil {
+			appState.Logger.WithField("action", "startup").WithError(closeErr).
+				Error("could not shut down components after failed startup")
+		}
+	}()
+
+	if opts.Embedded {
+		if appState.ServerConfig.Config.Monitoring.Enabled {
+			return nil, fmt.Errorf("monitoring is not supported in embedded mode")
+		}
+		if appState.ServerConfig.Config.RuntimeOverrides.Enabled {
+			return nil, fmt.Errorf("runtime overrides are not supported in embedded mode")
+		}
+	}
 
 	// initializing at the top to reflect the config changes before we pass on to different components.
-	initRuntimeOverrides(appState)
+	if err := initRuntimeOverrides(appState); err != nil {
+		return nil, err
+	}
 
 	if appState.ServerConfig.Config.Monitoring.Enabled {
 		appState.HTTPServerMetrics = monitoring.NewHTTPServerMetrics(monitoring.DefaultMetricsNamespace, prometheus.DefaultRegisterer)
@@ -239,14 +308,14 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		build.SetPrometheusBuildInfo()
 		prometheus.MustRegister(version.NewCollector(build.AppName))
 
-		opts := armonprometheus.PrometheusOpts{
+		promOpts := armonprometheus.PrometheusOpts{
 			Expiration: 0, // never expire any metrics,
 			Registerer: prometheus.DefaultRegisterer,
 		}
 
-		sink, err := armonprometheus.NewPrometheusSinkFrom(opts)
+		sink, err := armonprometheus.NewPrometheusSinkFrom(promOpts)
 		if err != nil {
-			appState.Logger.WithField("action", "startup").WithError(err).Fatal("failed to create prometheus sink for raft metrics")
+			return nil, fmt.Errorf("failed to create prometheus sink for raft metrics: %w", err)
 		}
 
 		cfg := armonmetrics.DefaultConfig("weaviate_internal") // to differentiate it's coming from internal/dependency packages.
@@ -259,7 +328,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 
 		_, err = armonmetrics.NewGlobal(cfg, sink)
 		if err != nil {
-			appState.Logger.WithField("action", "startup").WithError(err).Fatal("failed to create metric registry raft metrics")
+			return nil, fmt.Errorf("failed to create metric registry raft metrics: %w", err)
 		}
 
 		// only monitoring tool supported at the moment is prometheus
@@ -320,9 +389,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 			}),
 		})
 		if err != nil {
-			appState.Logger.
-				WithField("action", "startup").WithError(err).
-				Fatal("sentry initialization failed")
+			return nil, fmt.Errorf("sentry initialization failed: %w", err)
 		}
 
 		sentry.ConfigureScope(func(scope *sentry.Scope) {
@@ -340,19 +407,15 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 
 	limitResources(appState)
 
-	err := registerModules(appState)
+	err = registerModules(appState)
 	if err != nil {
-		appState.Logger.
-			WithField("action", "startup").WithError(err).
-			Fatal("modules didn't load")
+		return nil, fmt.Errorf("modules didn't load: %w", err)
 	}
 
 	// now that modules are loaded we can run the remaining config validation
 	// which is module dependent
 	if err := appState.ServerConfig.Config.ValidateModules(appState.Modules); err != nil {
-		appState.Logger.
-			WithField("action", "startup").WithError(err).
-			Fatal("invalid config")
+		return nil, fmt.Errorf("invalid config: %w", err)
 	}
 
 	appState.ClusterHttpClient = reasonableHttpClient(appState.ServerConfig.Config.Cluster.AuthConfig)
@@ -413,9 +476,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		MaximumConcurrentShardLoads: appState.ServerConfig.Config.MaximumConcurrentShardLoads,
 	}, remoteIndexClient, appState.Cluster, remoteNodesClient, replicationClient, appState.Metrics, appState.MemWatch) // TODO client
 	if err != nil {
-		appState.Logger.
-			WithField("action", "startup").WithError(err).
-			Fatal("invalid new DB")
+		return nil, fmt.Errorf("invalid new DB: %w", err)
 	}
 
 	appState.DB = repo
@@ -423,13 +484,15 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		appState.TenantActivity.SetSource(appState.DB)
 	}
 
-	setupDebugHandlers(appState)
-	setupGoProfiling(appState.ServerConfig.Config, appState.Logger)
+	if !opts.Embedded {
+		setupDebugHandlers(appState)
+		setupGoProfiling(appState.ServerConfig.Config, appState.Logger)
+	}
 
 	migrator := db.NewMigrator(repo, appState.Logger)
 	migrator.SetNode(appState.Cluster.LocalName())
-	// TODO-offload: "offload-s3" has to come from config when enable modules more than S3
-	migrator.SetOffloadProvider(appState.Modules, "offload-s3")
+	offloadModule, _ := appState.Modules.EnabledOffloadBackend()
+	migrator.SetOffloadProvider(appState.Modules, offloadModule)
 	appState.Migrator = migrator
 
 	vectorRepo = repo
@@ -437,20 +500,16 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	explorer := traverser.NewExplorer(repo, appState.Logger, appState.Modules, traverser.NewMetrics(appState.Metrics), appState.ServerConfig.Config)
 	schemaRepo := schemarepo.NewStore(appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
 	if err = schemaRepo.Open(); err != nil {
-		appState.Logger.
-			WithField("action", "startup").WithError(err).
-			Fatal("could not initialize schema repo")
-		os.Exit(1)
+		return nil, fmt.Errorf("could not initialize schema repo: %w", err)
 	}
+	appState.SchemaRepo = schemaRepo
 
 	localClassifierRepo, err := classifications.NewRepo(
 		appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
 	if err != nil {
-		appState.Logger.
-			WithField("action", "startup").WithError(err).
-			Fatal("could not initialize classifications repo")
-		os.Exit(1)
+		return nil, fmt.Errorf("could not initialize classifications repo: %w", err)
 	}
+	appState.LocalClassificationRepo = localClassifierRepo
 
 	// TODO: configure http transport for efficient intra-cluster comm
 	classificationsTxClient := clients.NewClusterClassifications(appState.ClusterHttpClient)
@@ -464,12 +523,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 
 	server2port, err := parseNode2Port(appState)
 	if len(server2port) == 0 || err != nil {
-		appState.Logger.
-			WithField("action", "startup").
-			WithField("raft-join", appState.ServerConfig.Config.Raft.Join).
-			WithError(err).
-			Fatal("parsing raft-join")
-		os.Exit(1)
+		return nil, fmt.Errorf("parsing raft-join %v: %w", appState.ServerConfig.Config.Raft.Join, err)
 	}
 
 	nodeName := appState.Cluster.LocalName()
@@ -509,6 +563,11 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		DynamicUserController:  appState.APIKey.Dynamic,
 		ReplicaCopier:          replicaCopier,
 	}
+	if opts.Embedded {
+		// a registry per instance, so that an instance can be started again
+		// after the previous one is closed
+		rConfig.MetricsRegisterer = prometheus.NewRegistry()
+	}
 	for _, name := range appState.ServerConfig.Config.Raft.Join[:rConfig.BootstrapExpect] {
 		if strings.Contains(name, rConfig.NodeID) {
 			rConfig.Voter = true
@@ -524,7 +583,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		appState.Logger, backup.RestoreClassDir(dataPath),
 	)
 
-	offloadmod, _ := appState.Modules.OffloadBackend("offload-s3")
+	offloadmod, _ := appState.Modules.OffloadBackend(offloadModule)
 
 	collectionRetrievalStrategyConfigFlag := configRuntime.NewFeatureFlag(
 		configRuntime.CollectionRetrievalStrategyLDKey,
@@ -545,13 +604,15 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		collectionRetrievalStrategyConfigFlag,
 	)
 	if err != nil {
-		appState.Logger.
-			WithField("action", "startup").WithError(err).
-			Fatal("could not initialize schema manager")
-		os.Exit(1)
+		return nil, fmt.Errorf("could not initialize schema manager: %w", err)
 	}
 
 	appState.SchemaManager = schemaManager
+	// without RBAC the roles are nil and the groups of a principal are used
+	// as its roles
+	appState.QueryTemplates = querytemplates.New(appState.ClusterService.Raft,
+		appState.ClusterService.SchemaReader(), appState.Authorizer, appState.PrincipalRoles,
+		grpcv1.ValidateSearchTemplate, appState.Logger)
 	appState.RemoteIndexIncoming = sharding.NewRemoteIndexIncoming(repo, appState.ClusterService.SchemaReader(), appState.Modules)
 	appState.RemoteNodeIncoming = sharding.NewRemoteNodeIncoming(repo)
 	appState.RemoteReplicaIncoming = replica.NewRemoteReplicaIncoming(repo, appState.ClusterService.SchemaReader())
@@ -560,7 +621,9 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		schemaManager, repo, appState.Modules)
 	appState.BackupManager = backupManager
 
-	enterrors.GoWrapper(func() { clusterapi.Serve(appState) }, appState.Logger)
+	if !opts.Embedded {
+		enterrors.GoWrapper(func() { clusterapi.Serve(appState) }, appState.Logger)
+	}
 
 	vectorRepo.SetSchemaGetter(schemaManager)
 	vectorRepo.SetRouter(appState.ClusterService.NewRouter(appState.Logger))
@@ -571,6 +634,9 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		appState.Logger, appState.Authorizer, vectorRepo, explorer, schemaManager,
 		appState.Modules, traverser.NewMetrics(appState.Metrics),
 		appState.ServerConfig.Config.MaximumConcurrentGetRequests)
+	appState.Traverser.SetMasker(appState.Masker)
+	appState.Traverser.SetScheduler(appState.QoS)
+	appState.Traverser.SetQueryCounter(appState.UsageQueries)
 
 	updateSchemaCallback := makeUpdateSchemaCall(appState)
 	executor.RegisterSchemaUpdateCallback(updateSchemaCallback)
@@ -583,9 +649,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 
 	err = initModules(moduleCtx, appState)
 	if err != nil {
-		appState.Logger.
-			WithField("action", "startup").WithError(err).
-			Fatal("modules didn't initialize")
+		return nil, fmt.Errorf("modules didn't initialize: %w", err)
 	}
 
 	metaStoreReadyErr := fmt.Errorf("meta store ready")
@@ -593,15 +657,21 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	storeReadyCtx, storeReadyCancel := context.WithCancelCause(context.Background())
 	enterrors.GoWrapper(func() {
 		if err := appState.ClusterService.Open(context.Background(), executor); err != nil {
-			appState.Logger.
+			entry := appState.Logger.
 				WithField("action", "startup").
-				WithError(err).
-				Fatal("could not open cloud meta store")
+				WithError(err)
+			if !opts.Embedded {
+				entry.Fatal("could not open cloud meta store")
+			}
+			// the host process of an embedded node keeps running, the node
+			// just never becomes ready
+			entry.Error("could not open cloud meta store")
 			storeReadyCancel(metaStoreFailedErr)
 		} else {
 			storeReadyCancel(metaStoreReadyErr)
 		}
 	}, appState.Logger)
+	metaStoreOpening = storeReadyCtx
 
 	// TODO-RAFT: refactor remove this sleep
 	// this sleep was used to block GraphQL and give time to RAFT to start.
@@ -614,11 +684,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 
 	err = migrator.AdjustFilterablePropSettings(ctx)
 	if err != nil {
-		appState.Logger.
-			WithError(err).
-			WithField("action", "adjustFilterablePropSettings").
-			Fatal("migration failed")
-		os.Exit(1)
+		return nil, fmt.Errorf("adjust filterable prop settings: migration failed: %w", err)
 	}
 
 	// FIXME to avoid import cycles, tasks are passed as strings
@@ -663,7 +729,8 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		}
 		if err := runReindexerV2(reindexCtx, waitForMetaStore, migrator, appState.Logger, reindexTasksV2Names,
 			reindexTasksV2Args, reindexFinishedV2); err != nil {
-			os.Exit(1) // fail only in case of error (effectively when reindexer is not created)
+			// fail only in case of error (effectively when reindexer is not created)
+			return nil, fmt.Errorf("create reindexer: %w", err)
 		}
 	}
 
@@ -697,7 +764,44 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		migrator.RecountProperties(ctx)
 	}
 
-	return appState
+	return appState, nil
+}
+
+// CloseAppState shuts down the components created by NewAppState that hold
+// listeners or open files: the raft store along with the db, gossip and the
+// node-local bolt stores. Components that were never created are skipped.
+func CloseAppState(ctx context.Context, appState *state.State) error {
+	var errs []error
+	if appState.ReindexCtxCancel != nil {
+		appState.ReindexCtxCancel()
+	}
+
+	// closes the raft store and, if it has been loaded, the db
+	if appState.ClusterService != nil {
+		if err := appState.ClusterService.Close(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("close cluster service: %w", err))
+		}
+	}
+	if appState.Cluster != nil {
+		if err := appState.Cluster.Shutdown(); err != nil {
+			errs = append(errs, fmt.Errorf("stop gossip: %w", err))
+		}
+	}
+	if appState.SchemaRepo != nil {
+		appState.SchemaRepo.Close()
+	}
+	if appState.LocalClassificationRepo != nil {
+		if err := appState.LocalClassificationRepo.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("close classifications repo: %w", err))
+		}
+	}
+	if appState.ModuleStorage != nil {
+		if err := appState.ModuleStorage.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("close module storage: %w", err))
+		}
+	}
+
+	return stderrors.Join(errs...)
 }
 
 func runReindexerV2(reindexCtx context.Context, waitForMetaStore func() error,
@@ -854,11 +958,18 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	objectsManager := objects.NewManager(appState.SchemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, appState.DB, appState.Modules,
 		objects.NewMetrics(appState.Metrics), appState.MemWatch)
+	objectsManager.SetMasker(appState.Masker)
+	objectsManager.SetQueryCounter(appState.UsageQueries)
 	setupObjectHandlers(api, objectsManager, appState.ServerConfig.Config, appState.Logger,
 		appState.Modules, appState.Metrics)
 	setupObjectBatchHandlers(api, appState.BatchManager, appState.Metrics, appState.Logger)
 	setupGraphQLHandlers(api, appState, appState.SchemaManager, appState.ServerConfig.Config.DisableGraphQL,
-		appState.Metrics, appState.Logger)
+		appState.ServerConfig.Config.GraphQLMaxBatchSize, appState.Metrics, appState.Logger)
+	setupGraphQLTemplateHandlers(api, appState, appState.SchemaManager, appState.QueryTemplates,
+		appState.ServerConfig.Config.DisableGraphQL, appState.Metrics, appState.Logger)
+	benchmarkHarness := benchmark.New(appState.ServerConfig.Config.Persistence.DataPath,
+		appState.SchemaManager, appState.BatchManager, appState.Traverser, appState.Authorizer, appState.Logger)
+	setupBenchmarkHandlers(api, benchmarkHarness, appState.Logger)
 	setupMiscHandlers(api, appState.ServerConfig, appState.Modules,
 		appState.Metrics, appState.Logger)
 	setupClassificationHandlers(api, classifier, appState.Metrics, appState.Logger)
@@ -885,6 +996,12 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 			}
 		}, appState.Logger)
 	}
+	usageReporter, err := StartUsageReporter(appState)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not start usage reporting")
+	}
 	if entcfg.Enabled(os.Getenv("ENABLE_CLEANUP_UNFINISHED_BACKUPS")) {
 		enterrors.GoWrapper(
 			func() {
@@ -907,6 +1024,28 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 			}
 		}
 
+		if usageReporter != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			// like telemetry, the final report must be written before the
+			// db is shut down
+			if err := usageReporter.Stop(ctx); err != nil {
+				appState.Logger.WithField("action", "stop_usage_reporting").
+					Errorf("failed to stop usage reporting: %s", err.Error())
+			}
+		}
+
+		{
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			// a running benchmark job still imports or queries, so it is
+			// stopped before the db is shut down
+			if err := benchmarkHarness.Shutdown(ctx); err != nil {
+				appState.Logger.WithField("action", "stop_benchmark_jobs").
+					Errorf("failed to stop benchmark jobs: %s", err.Error())
+			}
+		}
+
 		// stop reindexing on server shutdown
 		appState.ReindexCtxCancel()
 
@@ -945,7 +1084,9 @@ func startBackupScheduler(appState *state.State) *backup.Scheduler {
 }
 
 // TODO: Split up and don't write into global variables. Instead return an appState
-func startupRoutine(ctx context.Context, options *swag.CommandLineOptionsGroup) *state.State {
+func startupRoutine(ctx context.Context, options *swag.CommandLineOptionsGroup,
+	cfg *config.Config,
+) (*state.State, error) {
 	appState := &state.State{}
 
 	logger := logger()
@@ -962,16 +1103,18 @@ func startupRoutine(ctx context.Context, options *swag.CommandLineOptionsGroup)
 	// Load the config using the flags
 	serverConfig := &config.WeaviateConfig{}
 	appState.ServerConfig = serverConfig
-	err = serverConfig.LoadConfig(options, logger)
+	if cfg != nil {
+		serverConfig.Config = *cfg
+		err = serverConfig.Config.Validate()
+	} else {
+		err = serverConfig.LoadConfig(options, logger)
+	}
 	if err != nil {
-		logger.WithField("action", "startup").WithError(err).Error("could not load config")
-		logger.Exit(1)
+		return nil, fmt.Errorf("could not load config: %w", err)
 	}
 	dataPath := serverConfig.Config.Persistence.DataPath
 	if err := os.MkdirAll(dataPath, 0o777); err != nil {
-		logger.WithField("action", "startup").
-			WithField("path", dataPath).Error("cannot create data directory")
-		logger.Exit(1)
+		return nil, fmt.Errorf("cannot create data directory %q: %w", dataPath, err)
 	}
 
 	monitoring.InitConfig(serverConfig.Config.Monitoring)
@@ -1000,12 +1143,20 @@ func startupRoutine(ctx context.Context, options *swag.CommandLineOptionsGroup)
 	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
 		Debug("config loaded")
 
-	appState.OIDC = configureOIDC(appState)
-	appState.APIKey = configureAPIKey(appState)
+	if appState.OIDC, err = configureOIDC(appState); err != nil {
+		return nil, err
+	}
+	if appState.APIKey, err = configureAPIKey(appState); err != nil {
+		return nil, err
+	}
 	appState.AnonymousAccess = configureAnonymousAccess(appState)
 	if err = configureAuthorizer(appState); err != nil {
-		logger.WithField("action", "startup").WithField("error", err).Error("cannot configure authorizer")
-		logger.Exit(1)
+		return nil, fmt.Errorf("cannot configure authorizer: %w", err)
+	}
+	appState.Masker = configureMasker(appState)
+	appState.QoS = configureQoS(appState)
+	if serverConfig.Config.Usage.Enabled() {
+		appState.UsageQueries = usage.NewQueryCounter()
 	}
 
 	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
@@ -1020,9 +1171,7 @@ func startupRoutine(ctx context.Context, options *swag.CommandLineOptionsGroup)
 	}
 	clusterState, err := cluster.Init(serverConfig.Config.Cluster, dataPath, nonStorageNodes, logger)
 	if err != nil {
-		logger.WithField("action", "startup").WithError(err).
-			Error("could not init cluster state")
-		logger.Exit(1)
+		return nil, fmt.Errorf("could not init cluster state: %w", err)
 	}
 
 	appState.Cluster = clusterState
@@ -1030,7 +1179,7 @@ func startupRoutine(ctx context.Context, options *swag.CommandLineOptionsGroup)
 		WithField("action", "startup").
 		Debug("startup routine complete")
 
-	return appState
+	return appState, nil
 }
 
 // logger does not parse the regular config object, as logging needs to be
@@ -1125,6 +1274,18 @@ func registerModules(appState *state.State) error {
 		enabledModules[strings.TrimSpace(module)] = true
 	}
 
+	// frozen tenants are stored in exactly one place
+	var offloadModules []string
+	for _, name := range []string{modsloads3.Name, modsloadgcs.Name, modsloadazure.Name} {
+		if enabledModules[name] {
+			offloadModules = append(offloadModules, name)
+		}
+	}
+	if len(offloadModules) > 1 {
+		return fmt.Errorf("only one offload module can be enabled, got %s",
+			strings.Join(offloadModules, ", "))
+	}
+
 	if _, ok := enabledModules[modt2vbigram.Name]; ok {
 		appState.Modules.Register(modt2vbigram.New())
 		appState.Logger.
@@ -1483,6 +1644,22 @@ func registerModules(appState *state.State) error {
 			Debug("enabled module")
 	}
 
+	if _, ok := enabledModules[modsloadgcs.Name]; ok {
+		appState.Modules.Register(modsloadgcs.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modsloadgcs.Name).
+			Debug("enabled module")
+	}
+
+	if _, ok := enabledModules[modsloadazure.Name]; ok {
+		appState.Modules.Register(modsloadazure.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modsloadazure.Name).
+			Debug("enabled module")
+	}
+
 	if _, ok := enabledModules[modstggcs.Name]; ok {
 		appState.Modules.Register(modstggcs.New())
 		appState.Logger.
@@ -1600,6 +1777,7 @@ func initModules(ctx context.Context, appState *state.State) error {
 	if err != nil {
 		return errors.Wrap(err, "init storage provider")
 	}
+	appState.ModuleStorage = storageProvider
 
 	// TODO: gh-1481 don't pass entire appState in, but only what's needed. Probably only
 	// config?
@@ -1741,6 +1919,25 @@ func limitResources(appState *state.State) {
 	}
 }
 
+// StartUsageReporter starts periodic usage reporting if a sink is
+// configured. It returns nil otherwise.
+func StartUsageReporter(appState *state.State) (*usage.Reporter, error) {
+	cfg := appState.ServerConfig.Config.Usage
+	if !cfg.Enabled() {
+		return nil, nil
+	}
+
+	sink, err := usage.NewSink(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("could not create usage sink: %w", err)
+	}
+
+	reporter := usage.NewReporter(appState.Cluster.LocalName(), build.Version,
+		appState.DB, appState.UsageQueries, sink, cfg.Interval, appState.Logger)
+	reporter.Start()
+	return reporter, nil
+}
+
 func telemetryEnabled(state *state.State) bool {
 	return !state.ServerConfig.Config.DisableTelemetry
 }
@@ -1755,7 +1952,7 @@ func (m membership) LeaderID() string {
 	return id
 }
 
-func initRuntimeOverrides(appState *state.State) {
+func initRuntimeOverrides(appState *state.State) error {
 	// Enable runtime config manager
 	if appState.ServerConfig.Config.RuntimeOverrides.Enabled {
 		cm, err := configRuntime.NewConfigManager(
@@ -1765,8 +1962,7 @@ func initRuntimeOverrides(appState *state.State) {
 			appState.Logger,
 			prometheus.DefaultRegisterer)
 		if err != nil {
-			appState.Logger.WithField("action", "startup").WithError(err).Fatal("could not create runtime config manager")
-			os.Exit(1)
+			return fmt.Errorf("could not create runtime config manager: %w", err)
 		}
 
 		enterrors.GoWrapper(func() {
@@ -1784,4 +1980,5 @@ func initRuntimeOverrides(appState *state.State) {
 		appState.ServerConfig.Config.AutoSchema.EnabledFn = rc.GetAutoSchemaEnabled
 		appState.ServerConfig.Config.Replication.AsyncReplicationDisabledFn = rc.GetAsyncReplicationDisabled
 	}
+	return nil
 }
@@ -15,7 +15,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"os"
 	"path/filepath"
 	"time"
 
@@ -33,7 +32,9 @@ import (
 	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/masking"
 	"github.com/weaviate/weaviate/usecases/modules"
+	"github.com/weaviate/weaviate/usecases/qos"
 	"github.com/weaviate/weaviate/usecases/traverser"
 )
 
@@ -86,24 +87,22 @@ func rebuildGraphQL(updatedSchema schema.Schema, logger logrus.FieldLogger,
 // configureOIDC will always be called, even if OIDC is disabled, this way the
 // middleware will still be able to provide the user with a valuable error
 // message, even when OIDC is globally disabled.
-func configureOIDC(appState *state.State) *oidc.Client {
+func configureOIDC(appState *state.State) (*oidc.Client, error) {
 	c, err := oidc.New(appState.ServerConfig.Config)
 	if err != nil {
-		appState.Logger.WithField("action", "oidc_init").WithError(err).Fatal("oidc client could not start up")
-		os.Exit(1)
+		return nil, fmt.Errorf("oidc client could not start up: %w", err)
 	}
 
-	return c
+	return c, nil
 }
 
-func configureAPIKey(appState *state.State) *apikey.ApiKey {
+func configureAPIKey(appState *state.State) (*apikey.ApiKey, error) {
 	c, err := apikey.New(appState.ServerConfig.Config)
 	if err != nil {
-		appState.Logger.WithField("action", "api_keys_init").WithError(err).Fatal("apikey client could not start up")
-		os.Exit(1)
+		return nil, fmt.Errorf("apikey client could not start up: %w", err)
 	}
 
-	return c
+	return c, nil
 }
 
 // configureAnonymousAccess will always be called, even if anonymous access is
@@ -125,6 +124,7 @@ func configureAuthorizer(appState *state.State) error {
 		}
 
 		appState.AuthzController = rbacController
+		appState.PrincipalRoles = rbacController
 		appState.Authorizer = rbacController
 	} else if appState.ServerConfig.Config.Authorization.AdminList.Enabled {
 		appState.Authorizer = adminlist.New(appState.ServerConfig.Config.Authorization.AdminList)
@@ -141,6 +141,34 @@ func configureAuthorizer(appState *state.State) error {
 	return nil
 }
 
+func configureMasker(appState *state.State) *masking.Masker {
+	cfg := appState.ServerConfig.Config.DataMasking
+	if len(cfg.Policies) == 0 {
+		return nil
+	}
+
+	appState.Logger.WithField("action", "startup").WithField("policies", len(cfg.Policies)).
+		Info("data masking policies enabled")
+
+	// without RBAC the roles are nil and the groups of a principal are used
+	// as its roles
+	return masking.New(cfg, appState.PrincipalRoles, appState.Logger)
+}
+
+func configureQoS(appState *state.State) *qos.Scheduler {
+	cfg := appState.ServerConfig.Config.QoS
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	appState.Logger.WithField("action", "startup").WithField("classes", len(cfg.Classes)).
+		Info("qos classes enabled")
+
+	// without RBAC the roles are nil and the groups of a principal are used
+	// as its roles
+	return qos.New(cfg, appState.PrincipalRoles, appState.Logger)
+}
+
 func timeTillDeadline(ctx context.Context) string {
 	dl, _ := ctx.Deadline()
 	return time.Until(dl).String()
@@ -23,6 +23,7 @@ import (
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/authz"
 	cerrors "github.com/weaviate/weaviate/adapters/handlers/rest/errors"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/users"
@@ -70,13 +71,13 @@ func SetupHandlers(api *operations.WeaviateAPI, dbUsers DbUserAndRolesGetter, au
 		logger: logger,
 	}
 
-	api.UsersCreateUserHandler = users.CreateUserHandlerFunc(h.createUser)
-	api.UsersDeleteUserHandler = users.DeleteUserHandlerFunc(h.deleteUser)
-	api.UsersGetUserInfoHandler = users.GetUserInfoHandlerFunc(h.getUser)
-	api.UsersRotateUserAPIKeyHandler = users.RotateUserAPIKeyHandlerFunc(h.rotateKey)
-	api.UsersDeactivateUserHandler = users.DeactivateUserHandlerFunc(h.deactivateUser)
-	api.UsersActivateUserHandler = users.ActivateUserHandlerFunc(h.activateUser)
-	api.UsersListAllUsersHandler = users.ListAllUsersHandlerFunc(h.listUsers)
+	api.UsersCreateUserHandler = users.CreateUserHandlerFunc(authz.RequireAuthentication(h.createUser))
+	api.UsersDeleteUserHandler = users.DeleteUserHandlerFunc(authz.RequireAuthentication(h.deleteUser))
+	api.UsersGetUserInfoHandler = users.GetUserInfoHandlerFunc(authz.RequireAuthentication(h.getUser))
+	api.UsersRotateUserAPIKeyHandler = users.RotateUserAPIKeyHandlerFunc(authz.RequireAuthentication(h.rotateKey))
+	api.UsersDeactivateUserHandler = users.DeactivateUserHandlerFunc(authz.RequireAuthentication(h.deactivateUser))
+	api.UsersActivateUserHandler = users.ActivateUserHandlerFunc(authz.RequireAuthentication(h.activateUser))
+	api.UsersListAllUsersHandler = users.ListAllUsersHandlerFunc(authz.RequireAuthentication(h.listUsers))
 }
 
 func (h *dynUserHandler) listUsers(_ users.ListAllUsersParams, principal *models.Principal) middleware.Responder {
@@ -1721,6 +1721,160 @@ func init() {
         ]
       }
     },
+    "/benchmark/datasets": {
+      "post": {
+        "description": "Create a collection with random vectors and properties and start a background job importing its objects. Once all objects are imported without errors, the node keeps the spec of the dataset, so that it can be benchmarked later on. Requires the permission to manage the cluster.",
+        "tags": [
+          "benchmark"
+        ],
+        "summary": "Generate a synthetic dataset.",
+        "operationId": "benchmark.datasets.create",
+        "parameters": [
+          {
+            "description": "The dataset to generate.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BenchmarkDataset"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The collection was created and the import started.",
+            "schema": {
+              "$ref": "#/definitions/BenchmarkJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.benchmark.datasets.create"
+        ]
+      }
+    },
+    "/benchmark/jobs/{id}": {
+      "get": {
+        "description": "Returns the status of a dataset or benchmark job and its results once it has finished. Jobs are only known to the node that started them.",
+        "tags": [
+          "benchmark"
+        ],
+        "summary": "Get the status of a benchmark job.",
+        "operationId": "benchmark.jobs.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of the job.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The job was found.",
+            "schema": {
+              "$ref": "#/definitions/BenchmarkJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - the job does not exist on this node"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.benchmark.jobs.get"
+        ]
+      }
+    },
+    "/benchmark/runs": {
+      "post": {
+        "description": "Start a background job running queries against a synthetic dataset that was generated completely by this node. Once finished, the job reports their latency, throughput and, if requested, recall. Requires the permission to manage the cluster.",
+        "tags": [
+          "benchmark"
+        ],
+        "summary": "Benchmark queries against a synthetic dataset.",
+        "operationId": "benchmark.runs.create",
+        "parameters": [
+          {
+            "description": "The benchmark to run.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BenchmarkRun"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The benchmark started.",
+            "schema": {
+              "$ref": "#/definitions/BenchmarkJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.benchmark.runs.create"
+        ]
+      }
+    },
     "/classifications/": {
       "post": {
         "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
@@ -1864,6 +2018,93 @@ func init() {
         ]
       }
     },
+    "/cluster/write-modes": {
+      "get": {
+        "description": "Returns the cluster-wide write mode and the write modes of all collections which are not read-write.",
+        "tags": [
+          "cluster"
+        ],
+        "summary": "See the write modes.",
+        "operationId": "cluster.get.write.modes",
+        "responses": {
+          "200": {
+            "description": "The write modes.",
+            "schema": {
+              "$ref": "#/definitions/WriteModes"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.cluster.write.modes.get"
+        ]
+      },
+      "put": {
+        "description": "Set the cluster-wide write mode, or the write mode of a single collection. ` + "`" + `READ_ONLY` + "`" + ` rejects schema changes and data writes, ` + "`" + `SCHEMA_FROZEN` + "`" + ` only rejects schema changes and ` + "`" + `READ_WRITE` + "`" + ` accepts both. The stricter of the cluster-wide and the collection mode applies.",
+        "tags": [
+          "cluster"
+        ],
+        "summary": "Switch a write mode.",
+        "operationId": "cluster.update.write.mode",
+        "parameters": [
+          {
+            "description": "The write mode to set.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/WriteModeUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The write mode was switched, returns all write modes.",
+            "schema": {
+              "$ref": "#/definitions/WriteModes"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.cluster.write.modes.update"
+        ]
+      }
+    },
     "/graphql": {
       "post": {
         "description": "Get a response based on a GraphQL query",
@@ -1905,6 +2146,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "429": {
+            "description": "None of the queries were admitted by their QoS class, because too many queries are running or queued. Retry it later.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1980,19 +2227,19 @@ func init() {
         ]
       }
     },
-    "/meta": {
+    "/graphql/templates": {
       "get": {
-        "description": "Returns meta information about the server. Can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+        "description": "List the query templates the user may execute, users who may manage templates get all of them",
         "tags": [
-          "meta"
+          "graphql"
         ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
+        "summary": "List the query templates.",
+        "operationId": "graphql.templates.list",
         "responses": {
           "200": {
             "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Meta"
+              "$ref": "#/definitions/GraphQLTemplates"
             }
           },
           "401": {
@@ -2004,6 +2251,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2014,28 +2267,32 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.query"
         ]
-      }
-    },
-    "/nodes": {
-      "get": {
-        "description": "Returns node information for the entire database.",
+      },
+      "post": {
+        "description": "Create a query template, which gets its first version. Requires permissions to update the cluster.",
         "tags": [
-          "nodes"
+          "graphql"
         ],
-        "summary": "Node information for the database.",
-        "operationId": "nodes.get",
+        "summary": "Create a query template.",
+        "operationId": "graphql.templates.create",
         "parameters": [
           {
-            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
+            "description": "The template to create.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLTemplate"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Nodes status successfully returned",
+          "201": {
+            "description": "Template created.",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "$ref": "#/definitions/GraphQLTemplate"
             }
           },
           "401": {
@@ -2047,14 +2304,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Backup does not exist",
+          "409": {
+            "description": "A template with this name already exists.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2066,35 +2323,44 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.nodes.status.get"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/nodes/{className}": {
-      "get": {
-        "description": "Returns node information for the nodes relevant to the collection.",
+    "/graphql/templates/{name}": {
+      "put": {
+        "description": "Replace a query template, which gets a new version. Requires permissions to update the cluster.",
         "tags": [
-          "nodes"
+          "graphql"
         ],
-        "summary": "Node information for a collection.",
-        "operationId": "nodes.get.class",
+        "summary": "Update a query template.",
+        "operationId": "graphql.templates.update",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "Name of the template.",
+            "name": "name",
             "in": "path",
             "required": true
           },
           {
-            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
-          }
-        ],
-        "responses": {
+            "description": "The new template.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLTemplate"
+            }
+          }
+        ],
+        "responses": {
           "200": {
-            "description": "Nodes status successfully returned",
+            "description": "Template updated.",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "$ref": "#/definitions/GraphQLTemplate"
             }
           },
           "401": {
@@ -2107,13 +2373,19 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
+            "description": "Template not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The template changed since the given version.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2125,56 +2397,42 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.nodes.status.get.class"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/objects": {
-      "get": {
-        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
+      },
+      "post": {
+        "description": "Execute a stored GraphQL template by name with the given variables",
         "tags": [
-          "objects"
+          "graphql"
         ],
-        "summary": "Get a list of Objects.",
-        "operationId": "objects.list",
+        "summary": "Execute a GraphQL template.",
+        "operationId": "graphql.templates.execute",
         "parameters": [
           {
-            "$ref": "#/parameters/CommonAfterParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonOffsetParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonLimitParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonSortParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonOrderParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonClassParameterQuery"
+            "type": "string",
+            "description": "Name of the template.",
+            "name": "name",
+            "in": "path",
+            "required": true
           },
           {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "description": "The variables of the template.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLTemplateExecution"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response. \u003cbr/\u003e\u003cbr/\u003eIf ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
-            "schema": {
-              "$ref": "#/definitions/ObjectsListResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/GraphQLResponse"
             }
           },
           "401": {
@@ -2187,7 +2445,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Template not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
@@ -2195,6 +2456,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "429": {
+            "description": "None of the queries were admitted by their QoS class, because too many queries are running or queued. Retry it later.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2208,38 +2475,25 @@ func init() {
           "weaviate.local.query"
         ]
       },
-      "post": {
-        "description": "Create a new object. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: Use ` + "`" + `/batch` + "`" + ` for importing many objects**: \u003cbr/\u003eIf you plan on importing a large number of objects, it's much more efficient to use the ` + "`" + `/batch` + "`" + ` endpoint. Otherwise, sending multiple single requests sequentially would incur a large performance penalty. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/objects` + "`" + `**: \u003cbr/\u003ePOST /objects will fail if an id is provided which already exists in the class. To update an existing object with the objects endpoint, use the PUT or PATCH method.",
+      "delete": {
+        "description": "Delete a query template by name. Requires permissions to update the cluster.",
         "tags": [
-          "objects"
+          "graphql"
         ],
-        "summary": "Create a new object.",
-        "operationId": "objects.create",
+        "summary": "Delete a query template.",
+        "operationId": "graphql.templates.delete",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "type": "string",
+            "description": "Name of the template.",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Object created.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "Template deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2250,8 +2504,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+          "404": {
+            "description": "Template not found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2266,31 +2520,24 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/objects/validate": {
-      "post": {
-        "description": "Validate an object's schema and meta-data without creating it. \u003cbr/\u003e\u003cbr/\u003eIf the schema of the object is valid, the request should return nothing with a plain RESTful request. Otherwise, an error object will be returned.",
+    "/meta": {
+      "get": {
+        "description": "Returns meta information about the server. Can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
         "tags": [
-          "objects"
-        ],
-        "summary": "Validate an Object based on a schema.",
-        "operationId": "objects.validate",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          }
+          "meta"
         ],
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
         "responses": {
           "200": {
-            "description": "Successfully validated."
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Meta"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2301,12 +2548,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2321,53 +2562,24 @@ func init() {
         ]
       }
     },
-    "/objects/{className}/{id}": {
+    "/nodes": {
       "get": {
-        "description": "Get a data object based on its collection and UUID. Also available as Websocket bus.",
+        "description": "Returns node information for the entire database.",
         "tags": [
-          "objects"
+          "nodes"
         ],
-        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
-        "operationId": "objects.class.get",
+        "summary": "Node information for the database.",
+        "operationId": "nodes.get",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonNodeNameParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Nodes status successfully returned",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/NodesStatusResponse"
             }
           },
           "401": {
@@ -2380,10 +2592,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2395,19 +2610,19 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.nodes.status.get"
         ]
-      },
-      "put": {
-        "description": "Update an object based on its uuid and collection. This (` + "`" + `put` + "`" + `) method replaces the object with the provided object.",
+      }
+    },
+    "/nodes/{className}": {
+      "get": {
+        "description": "Returns node information for the nodes relevant to the collection.",
         "tags": [
-          "objects"
+          "nodes"
         ],
-        "summary": "Update a class object based on its uuid",
-        "operationId": "objects.class.put",
+        "summary": "Node information for a collection.",
+        "operationId": "nodes.get.class",
         "parameters": [
           {
             "type": "string",
@@ -2416,30 +2631,14 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object to update.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully received.",
+            "description": "Nodes status successfully returned",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/NodesStatusResponse"
             }
           },
           "401": {
@@ -2452,10 +2651,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2467,44 +2669,57 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.nodes.status.get.class"
         ]
-      },
-      "delete": {
-        "description": "Delete an object based on its collection and UUID. \u003cbr/\u003e\u003cbr/\u003eNote: For backward compatibility, beacons also support an older, deprecated format without the collection name. As a result, when deleting a reference, the beacon specified has to match the beacon to be deleted exactly. In other words, if a beacon is present using the old format (without collection name) you also need to specify it the same way. \u003cbr/\u003e\u003cbr/\u003eIn the beacon format, you need to always use ` + "`" + `localhost` + "`" + ` as the host, rather than the actual hostname. ` + "`" + `localhost` + "`" + ` here refers to the fact that the beacon's target is on the same Weaviate instance, as opposed to a foreign instance.",
-        "tags": [
-          "objects"
+      }
+    },
+    "/objects": {
+      "get": {
+        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
+        "tags": [
+          "objects"
         ],
-        "summary": "Delete object based on its class and UUID.",
-        "operationId": "objects.class.delete",
+        "summary": "Get a list of Objects.",
+        "operationId": "objects.list",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/CommonAfterParameterQuery"
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/CommonOffsetParameterQuery"
           },
           {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "$ref": "#/parameters/CommonLimitParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonPropertiesParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonExcludePropertiesParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonSortParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonOrderParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonClassParameterQuery"
           },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Successful response. \u003cbr/\u003e\u003cbr/\u003eIf ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
+            "schema": {
+              "$ref": "#/definitions/ObjectsListResponse"
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -2525,7 +2740,7 @@ func init() {
             "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2537,45 +2752,44 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
       },
-      "head": {
-        "description": "Checks if a data object exists based on its collection and uuid without retrieving it. \u003cbr/\u003e\u003cbr/\u003eInternally it skips reading the object from disk other than checking if it is present. Thus it does not use resources on marshalling, parsing, etc., and is faster. Note the resulting HTTP request has no body; the existence of an object is indicated solely by the status code.",
+      "post": {
+        "description": "Create a new object. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: Use ` + "`" + `/batch` + "`" + ` for importing many objects**: \u003cbr/\u003eIf you plan on importing a large number of objects, it's much more efficient to use the ` + "`" + `/batch` + "`" + ` endpoint. Otherwise, sending multiple single requests sequentially would incur a large performance penalty. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/objects` + "`" + `**: \u003cbr/\u003ePOST /objects will fail if an id is provided which already exists in the class. To update an existing object with the objects endpoint, use the PUT or PATCH method.",
         "tags": [
           "objects"
         ],
-        "summary": "Checks object's existence based on its class and uuid.",
-        "operationId": "objects.class.head",
+        "summary": "Create a new object.",
+        "operationId": "objects.create",
         "parameters": [
           {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "Object created.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2586,11 +2800,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Object doesn't exist."
-          },
           "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2602,56 +2813,34 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.add"
         ]
-      },
-      "patch": {
-        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/objects/validate": {
+      "post": {
+        "description": "Validate an object's schema and meta-data without creating it. \u003cbr/\u003e\u003cbr/\u003eIf the schema of the object is valid, the request should return nothing with a plain RESTful request. Otherwise, an error object will be returned.",
         "tags": [
           "objects"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.class.patch",
+        "summary": "Validate an Object based on a schema.",
+        "operationId": "objects.validate",
         "parameters": [
           {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object to update.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
               "$ref": "#/definitions/Object"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "200": {
+            "description": "Successfully validated."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2662,11 +2851,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2681,22 +2867,21 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/objects/{className}/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace **all** references in cross-reference property of an object.",
+    "/objects/{className}/{id}": {
+      "get": {
+        "description": "Get a data object based on its collection and UUID. Also available as Websocket bus.",
         "tags": [
           "objects"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.class.references.put",
+        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
+        "operationId": "objects.class.get",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
@@ -2710,30 +2895,30 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/MultipleRef"
-            }
+            "$ref": "#/parameters/CommonPropertiesParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonExcludePropertiesParameterQuery"
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
+          {
+            "$ref": "#/parameters/CommonNodeNameParameterQuery"
+          },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -2751,10 +2936,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Source object doesn't exist."
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2769,20 +2954,19 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
       },
-      "post": {
-        "description": "Add a single reference to an object. This adds a reference to the array of cross-references of the given property in the source object specified by its collection name and id",
+      "put": {
+        "description": "Update an object based on its uuid and collection. This (` + "`" + `put` + "`" + `) method replaces the object with the provided object.",
         "tags": [
           "objects"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.class.references.create",
+        "summary": "Update a class object based on its uuid",
+        "operationId": "objects.class.put",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
@@ -2790,41 +2974,28 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The uuid of the data object to update.",
             "name": "id",
             "in": "path",
             "required": true
           },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/Object"
             }
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Successfully received.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Object"
             }
           },
           "401": {
@@ -2837,10 +3008,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Source object doesn't exist."
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2859,16 +3030,15 @@ func init() {
         ]
       },
       "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "description": "Delete an object based on its collection and UUID. \u003cbr/\u003e\u003cbr/\u003eNote: For backward compatibility, beacons also support an older, deprecated format without the collection name. As a result, when deleting a reference, the beacon specified has to match the beacon to be deleted exactly. In other words, if a beacon is present using the old format (without collection name) you also need to specify it the same way. \u003cbr/\u003e\u003cbr/\u003eIn the beacon format, you need to always use ` + "`" + `localhost` + "`" + ` as the host, rather than the actual hostname. ` + "`" + `localhost` + "`" + ` here refers to the fact that the beacon's target is on the same Weaviate instance, as opposed to a foreign instance.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.class.references.delete",
+        "summary": "Delete object based on its class and UUID.",
+        "operationId": "objects.class.delete",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
@@ -2881,21 +3051,6 @@ func init() {
             "in": "path",
             "required": true
           },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
@@ -2923,13 +3078,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2941,47 +3093,45 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/objects/{id}": {
-      "get": {
-        "description": "Get a specific object based on its UUID. Also available as Websocket bus.",
+      },
+      "head": {
+        "description": "Checks if a data object exists based on its collection and uuid without retrieving it. \u003cbr/\u003e\u003cbr/\u003eInternally it skips reading the object from disk other than checking if it is present. Thus it does not use resources on marshalling, parsing, etc., and is faster. Note the resulting HTTP request has no body; the existence of an object is indicated solely by the status code.",
         "tags": [
           "objects"
         ],
-        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
-        "operationId": "objects.get",
-        "deprecated": true,
+        "summary": "Checks object's existence based on its class and uuid.",
+        "operationId": "objects.class.head",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The uuid of the data object",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "Object exists."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2993,7 +3143,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Object doesn't exist."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3002,33 +3158,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.manipulate"
         ]
       },
-      "put": {
-        "description": "Updates an object based on its UUID. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "patch": {
+        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
           "objects"
         ],
-        "summary": "Update an Object based on its UUID.",
-        "operationId": "objects.update",
-        "deprecated": true,
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.class.patch",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The uuid of the data object to update.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
             "name": "body",
             "in": "body",
-            "required": true,
             "schema": {
               "$ref": "#/definitions/Object"
             }
@@ -3038,10 +3200,13 @@ func init() {
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully received.",
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -3057,7 +3222,7 @@ func init() {
             "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The patch-JSON is valid but unprocessable.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3074,16 +3239,24 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      },
-      "delete": {
-        "description": "Deletes an object from the database based on its UUID.",
+      }
+    },
+    "/objects/{className}/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace **all** references in cross-reference property of an object.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete an Object based on its UUID.",
-        "operationId": "objects.delete",
-        "deprecated": true,
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.class.references.put",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -3092,6 +3265,21 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
+          },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
@@ -3100,8 +3288,14 @@ func init() {
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Successfully replaced all the references."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3113,7 +3307,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Source object doesn't exist."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3122,21 +3322,27 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
       },
-      "head": {
-        "description": "Checks if an object exists in the system based on its UUID.",
+      "post": {
+        "description": "Add a single reference to an object. This adds a reference to the array of cross-references of the given property in the source object specified by its collection name and id",
         "tags": [
           "objects"
         ],
-        "summary": "Checks Object's existence based on its UUID.",
-        "operationId": "objects.head",
-        "deprecated": true,
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.class.references.create",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -3144,11 +3350,38 @@ func init() {
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "Successfully added the reference."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3160,7 +3393,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Object doesn't exist."
+            "description": "Source object doesn't exist."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3169,21 +3408,27 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.objects.check"
+          "weaviate.local.manipulate"
         ]
       },
-      "patch": {
-        "description": "Update an object based on its UUID (using patch semantics). This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
         "tags": [
           "objects"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.patch",
-        "deprecated": true,
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.class.references.delete",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -3193,23 +3438,36 @@ func init() {
             "required": true
           },
           {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/SingleRef"
             }
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "204": {
-            "description": "Successfully applied. No content provided."
+            "description": "Successfully deleted."
           },
           "400": {
-            "description": "The patch-JSON is malformed."
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3221,10 +3479,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3243,14 +3504,14 @@ func init() {
         ]
       }
     },
-    "/objects/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references in cross-reference property of an object.",
+    "/objects/{id}": {
+      "get": {
+        "description": "Get a specific object based on its UUID. Also available as Websocket bus.",
         "tags": [
           "objects"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.references.update",
+        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
+        "operationId": "objects.get",
         "deprecated": true,
         "parameters": [
           {
@@ -3262,27 +3523,27 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/MultipleRef"
-            }
+            "$ref": "#/parameters/CommonPropertiesParameterQuery"
           },
           {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "$ref": "#/parameters/CommonExcludePropertiesParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3293,11 +3554,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "Successful query result but no resource was found."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3309,16 +3567,16 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
       },
-      "post": {
-        "description": "Add a cross-reference.",
+      "put": {
+        "description": "Updates an object based on its UUID. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
           "objects"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.references.create",
+        "summary": "Update an Object based on its UUID.",
+        "operationId": "objects.update",
         "deprecated": true,
         "parameters": [
           {
@@ -3329,28 +3587,24 @@ func init() {
             "in": "path",
             "required": true
           },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/Object"
             }
           },
           {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3361,8 +3615,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3381,12 +3638,12 @@ func init() {
         ]
       },
       "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "description": "Deletes an object from the database based on its UUID.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.references.delete",
+        "summary": "Delete an Object based on its UUID.",
+        "operationId": "objects.delete",
         "deprecated": true,
         "parameters": [
           {
@@ -3398,19 +3655,7 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
@@ -3430,10 +3675,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successful query result but no resource was found."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3442,39 +3684,33 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/replication/replicate": {
-      "post": {
+      },
+      "head": {
+        "description": "Checks if an object exists in the system based on its UUID.",
         "tags": [
-          "replication"
+          "objects"
         ],
-        "summary": "Start the async operation to replicate a replica between two nodes",
-        "operationId": "replicate",
+        "summary": "Checks Object's existence based on its UUID.",
+        "operationId": "objects.head",
+        "deprecated": true,
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ReplicationReplicateReplicaRequest"
-            }
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Replication operation registered successfully"
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "Object exists."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3485,11 +3721,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "Object doesn't exist."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3498,34 +3731,47 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.replication.replicate"
+          "weaviate.objects.check"
         ]
-      }
-    },
-    "/schema": {
-      "get": {
-        "description": "Fetch an array of all collection definitions from the schema.",
+      },
+      "patch": {
+        "description": "Update an object based on its UUID (using patch semantics). This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.patch",
+        "deprecated": true,
         "parameters": [
           {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
-            "in": "header"
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully dumped the database schema.",
-            "schema": {
-              "$ref": "#/definitions/Schema"
-            }
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3536,6 +3782,15 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -3543,33 +3798,53 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate"
         ]
-      },
-      "post": {
-        "description": "Create a new data object collection. \u003cbr/\u003e\u003cbr/\u003eIf AutoSchema is enabled, Weaviate will attempt to infer the schema from the data at import time. However, manual schema definition is recommended for production environments.",
+      }
+    },
+    "/objects/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references in cross-reference property of an object.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Create a new Object class in the schema.",
-        "operationId": "schema.objects.create",
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.references.update",
+        "deprecated": true,
         "parameters": [
           {
-            "name": "objectClass",
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/MultipleRef"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the new Object class to the schema.",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+            "description": "Successfully replaced all the references."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3581,7 +3856,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid Object class",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3593,39 +3868,51 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/schema/{className}": {
-      "get": {
+      },
+      "post": {
+        "description": "Add a cross-reference.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Get a single class from the schema",
-        "operationId": "schema.objects.get",
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.references.create",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
-            "in": "header"
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the Class, returned as body",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+            "description": "Successfully added the reference."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3636,8 +3923,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "This class does not exist"
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3646,39 +3936,51 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.local.manipulate"
         ]
       },
-      "put": {
-        "description": "Add a property to an existing collection.",
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Update settings of an existing schema class",
-        "operationId": "schema.objects.update",
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.references.delete",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "name": "objectClass",
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/SingleRef"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Class was updated successfully",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3690,13 +3992,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Class to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid update attempt",
+            "description": "Successful query result but no resource was found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3708,31 +4004,36 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.manipulate"
         ]
-      },
-      "delete": {
-        "description": "Remove a collection from the schema. This will also delete all the objects in the collection.",
+      }
+    },
+    "/replication/replicate": {
+      "post": {
         "tags": [
-          "schema"
+          "replication"
         ],
-        "summary": "Remove an Object class (and all data in the instances) from the schema.",
-        "operationId": "schema.objects.delete",
+        "summary": "Start the async operation to replicate a replica between two nodes",
+        "operationId": "replicate",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ReplicationReplicateReplicaRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Removed the Object class from the schema."
+            "description": "Replication operation registered successfully"
           },
           "400": {
-            "description": "Could not delete the Object class.",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3746,6 +4047,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -3754,38 +4061,76 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.replication.replicate"
         ]
       }
     },
-    "/schema/{className}/properties": {
-      "post": {
+    "/schema": {
+      "get": {
+        "description": "Fetch an array of all collection definitions from the schema.",
         "tags": [
           "schema"
         ],
-        "summary": "Add a property to an Object class.",
-        "operationId": "schema.objects.properties.add",
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully dumped the database schema.",
+            "schema": {
+              "$ref": "#/definitions/Schema"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "post": {
+        "description": "Create a new data object collection. \u003cbr/\u003e\u003cbr/\u003eIf AutoSchema is enabled, Weaviate will attempt to infer the schema from the data at import time. However, manual schema definition is recommended for production environments.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create a new Object class in the schema.",
+        "operationId": "schema.objects.create",
+        "parameters": [
           {
-            "name": "body",
+            "name": "objectClass",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/Class"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the property.",
+            "description": "Added the new Object class to the schema.",
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -3798,7 +4143,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid property.",
+            "description": "Invalid Object class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3811,18 +4156,17 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.add.meta"
         ]
       }
     },
-    "/schema/{className}/shards": {
+    "/schema/{className}": {
       "get": {
-        "description": "Get the status of every shard in the cluster.",
         "tags": [
           "schema"
         ],
-        "summary": "Get the shards status of an Object class",
-        "operationId": "schema.objects.shards.get",
+        "summary": "Get a single class from the schema",
+        "operationId": "schema.objects.get",
         "parameters": [
           {
             "type": "string",
@@ -3831,16 +4175,18 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "name": "tenant",
-            "in": "query"
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the status of the shards, returned as body",
+            "description": "Found the Class, returned as body",
             "schema": {
-              "$ref": "#/definitions/ShardStatusList"
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -3853,10 +4199,7 @@ func init() {
             }
           },
           "404": {
-            "description": "This class does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "This class does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3868,16 +4211,14 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.get.meta"
         ]
-      }
-    },
-    "/schema/{className}/shards/{shardName}": {
+      },
       "put": {
-        "description": "Update a shard status for a collection. For example, a shard may have been marked as ` + "`" + `READONLY` + "`" + ` because its disk was full. After providing more disk space, use this endpoint to set the shard status to ` + "`" + `READY` + "`" + ` again. There is also a convenience function in each client to set the status of all shards of a collection.",
+        "description": "Add a property to an existing collection.",
         "tags": [
           "schema"
         ],
-        "summary": "Update a shard status.",
-        "operationId": "schema.objects.shards.update",
+        "summary": "Update settings of an existing schema class",
+        "operationId": "schema.objects.update",
         "parameters": [
           {
             "type": "string",
@@ -3886,25 +4227,19 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "name": "shardName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
+            "name": "objectClass",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/Class"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Shard status was updated successfully",
+            "description": "Class was updated successfully",
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -3917,7 +4252,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Shard to be updated does not exist",
+            "description": "Class to be updated does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3938,8 +4273,235 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate.meta"
         ]
-      }
-    },
+      },
+      "delete": {
+        "description": "Remove a collection from the schema. This will also delete all the objects in the collection.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Remove an Object class (and all data in the instances) from the schema.",
+        "operationId": "schema.objects.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Removed the Object class from the schema."
+          },
+          "400": {
+            "description": "Could not delete the Object class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/properties": {
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Add a property to an Object class.",
+        "operationId": "schema.objects.properties.add",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid property.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/shards": {
+      "get": {
+        "description": "Get the status of every shard in the cluster.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the shards status of an Object class",
+        "operationId": "schema.objects.shards.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the status of the shards, returned as body",
+            "schema": {
+              "$ref": "#/definitions/ShardStatusList"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      }
+    },
+    "/schema/{className}/shards/{shardName}": {
+      "put": {
+        "description": "Update a shard status for a collection. For example, a shard may have been marked as ` + "`" + `READONLY` + "`" + ` because its disk was full. After providing more disk space, use this endpoint to set the shard status to ` + "`" + `READY` + "`" + ` again. There is also a convenience function in each client to set the status of all shards of a collection.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Update a shard status.",
+        "operationId": "schema.objects.shards.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Shard status was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Shard to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
     "/schema/{className}/tenants": {
       "get": {
         "description": "get all tenants from a specific class",
@@ -5224,33 +5786,269 @@ func init() {
         }
       }
     },
-    "C11yExtension": {
-      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+    "BenchmarkDataset": {
+      "description": "A synthetic collection for benchmarks. Generating the same dataset twice results in exactly the same objects and vectors.",
+      "type": "object",
       "properties": {
-        "concept": {
-          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
-          "type": "string",
-          "example": "foobarium"
+        "batchSize": {
+          "description": "Number of objects imported per batch. Defaults to 1000.",
+          "type": "integer",
+          "format": "int64"
         },
-        "definition": {
-          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
+        "collection": {
+          "description": "Name of the collection to create.",
           "type": "string"
         },
-        "weight": {
-          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
-          "type": "number",
-          "format": "float"
-        }
-      }
-    },
-    "C11yNearestNeighbors": {
-      "description": "C11y function to show the nearest neighbors to a word.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "distance": {
-            "type": "number",
+        "dimensions": {
+          "description": "Number of dimensions of the random vectors.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "distance": {
+          "description": "Distance metric of the vector index, one of ` + "`" + `cosine` + "`" + ` (default), ` + "`" + `dot` + "`" + ` or ` + "`" + `l2-squared` + "`" + `.",
+          "type": "string"
+        },
+        "objects": {
+          "description": "Number of objects to generate.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "properties": {
+          "description": "Properties of the generated objects.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BenchmarkDatasetProperty"
+          }
+        },
+        "seed": {
+          "description": "Seed of the random values.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "BenchmarkDatasetProperty": {
+      "description": "How the values of a synthetic property are distributed.",
+      "type": "object",
+      "properties": {
+        "cardinality": {
+          "description": "Number of distinct values of ` + "`" + `text` + "`" + ` and ` + "`" + `int` + "`" + ` properties. Defaults to 100.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "dataType": {
+          "description": "Data type of the property, one of ` + "`" + `text` + "`" + `, ` + "`" + `int` + "`" + `, ` + "`" + `number` + "`" + ` or ` + "`" + `boolean` + "`" + `.",
+          "type": "string"
+        },
+        "distribution": {
+          "description": "Distribution of the values, ` + "`" + `uniform` + "`" + ` (default), ` + "`" + `zipf` + "`" + ` for ` + "`" + `text` + "`" + ` and ` + "`" + `int` + "`" + ` properties or ` + "`" + `normal` + "`" + ` for ` + "`" + `number` + "`" + ` properties.",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the property.",
+          "type": "string"
+        }
+      }
+    },
+    "BenchmarkDatasetReport": {
+      "description": "Summary of the import of a synthetic dataset.",
+      "type": "object",
+      "properties": {
+        "collection": {
+          "description": "Name of the created collection.",
+          "type": "string"
+        },
+        "durationMs": {
+          "description": "Duration of the import in milliseconds.",
+          "type": "number"
+        },
+        "errors": {
+          "description": "Number of objects that could not be imported.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objects": {
+          "description": "Number of imported objects.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsPerSecond": {
+          "description": "Imported objects per second.",
+          "type": "number"
+        }
+      }
+    },
+    "BenchmarkJob": {
+      "description": "A background job generating a synthetic dataset or running a benchmark.",
+      "type": "object",
+      "properties": {
+        "collection": {
+          "description": "Name of the collection of the job.",
+          "type": "string"
+        },
+        "datasetReport": {
+          "description": "Summary of the import, set for ` + "`" + `dataset` + "`" + ` jobs.",
+          "$ref": "#/definitions/BenchmarkDatasetReport"
+        },
+        "error": {
+          "description": "Why the job failed.",
+          "type": "string"
+        },
+        "id": {
+          "description": "ID of the job.",
+          "type": "string"
+        },
+        "runReport": {
+          "description": "Results of the benchmark, set for finished ` + "`" + `run` + "`" + ` jobs.",
+          "$ref": "#/definitions/BenchmarkRunReport"
+        },
+        "status": {
+          "description": "Status of the job, one of ` + "`" + `STARTED` + "`" + `, ` + "`" + `SUCCESS` + "`" + ` or ` + "`" + `FAILED` + "`" + `.",
+          "type": "string"
+        },
+        "type": {
+          "description": "Type of the job, ` + "`" + `dataset` + "`" + ` or ` + "`" + `run` + "`" + `.",
+          "type": "string"
+        }
+      }
+    },
+    "BenchmarkLatency": {
+      "description": "Query latencies in milliseconds.",
+      "type": "object",
+      "properties": {
+        "max": {
+          "description": "Slowest query.",
+          "type": "number"
+        },
+        "mean": {
+          "description": "Mean latency.",
+          "type": "number"
+        },
+        "p50": {
+          "description": "50th percentile.",
+          "type": "number"
+        },
+        "p90": {
+          "description": "90th percentile.",
+          "type": "number"
+        },
+        "p99": {
+          "description": "99th percentile.",
+          "type": "number"
+        }
+      }
+    },
+    "BenchmarkRun": {
+      "description": "A query benchmark against a synthetic dataset.",
+      "type": "object",
+      "properties": {
+        "collection": {
+          "description": "Name of a collection created as a synthetic dataset.",
+          "type": "string"
+        },
+        "concurrency": {
+          "description": "Number of queries sent in parallel. Defaults to 1.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "limit": {
+          "description": "Number of results per query. Defaults to 10.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "queries": {
+          "description": "Number of queries to run. Defaults to 100.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "queryType": {
+          "description": "Type of the queries, ` + "`" + `nearVector` + "`" + ` (default) or ` + "`" + `bm25` + "`" + `.",
+          "type": "string"
+        },
+        "recall": {
+          "description": "Compare the results of ` + "`" + `nearVector` + "`" + ` queries with an exact brute force search over the dataset.",
+          "type": "boolean"
+        },
+        "seed": {
+          "description": "Seed of the random query vectors and terms.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "BenchmarkRunReport": {
+      "description": "Latency, throughput and recall of a benchmark run.",
+      "type": "object",
+      "properties": {
+        "collection": {
+          "description": "Name of the benchmarked collection.",
+          "type": "string"
+        },
+        "concurrency": {
+          "description": "Number of queries sent in parallel.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "durationMs": {
+          "description": "Duration of the run in milliseconds.",
+          "type": "number"
+        },
+        "errors": {
+          "description": "Number of failed queries.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "latencyMs": {
+          "description": "Latencies of the successful queries.",
+          "$ref": "#/definitions/BenchmarkLatency"
+        },
+        "queries": {
+          "description": "Number of queries.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "queryType": {
+          "description": "Type of the queries.",
+          "type": "string"
+        },
+        "recall": {
+          "description": "Mean recall of the queries, only set if requested.",
+          "type": "number",
+          "x-nullable": true
+        },
+        "throughputQPS": {
+          "description": "Successful queries per second.",
+          "type": "number"
+        }
+      }
+    },
+    "C11yExtension": {
+      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+      "properties": {
+        "concept": {
+          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
+          "type": "string",
+          "example": "foobarium"
+        },
+        "definition": {
+          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
+          "type": "string"
+        },
+        "weight": {
+          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "C11yNearestNeighbors": {
+      "description": "C11y function to show the nearest neighbors to a word.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "distance": {
+            "type": "number",
             "format": "float"
           },
           "word": {
@@ -5756,6 +6554,62 @@ func init() {
         "$ref": "#/definitions/GraphQLResponse"
       }
     },
+    "GraphQLTemplate": {
+      "description": "A named, parameterized query stored in the schema. It holds either a GraphQL query, which is executed through this API, or a search request of the gRPC API, which is executed through its ExecuteTemplate method.",
+      "type": "object",
+      "properties": {
+        "description": {
+          "description": "What the template is used for.",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name the template is executed by.",
+          "type": "string"
+        },
+        "query": {
+          "description": "GraphQL query of the template. Its variables are set when the template is executed. Only one of query and search may be set.",
+          "type": "string"
+        },
+        "roles": {
+          "description": "Roles which may execute the template. If empty, everyone may execute it.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "search": {
+          "description": "Search request of the gRPC API (weaviate.v1.SearchRequest) in its JSON representation. String values of the form \"$name\" are variables, which are replaced by their values when the template is executed. Strings starting with \"$$\" stand for the same string with a single leading \"$\". Only one of query and search may be set.",
+          "type": "object"
+        },
+        "version": {
+          "description": "Version of the template, set by the server whenever the template changes. It is ignored when a template is created. When a template is updated, the update is only applied if the template still has this version, unless it is 0.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "GraphQLTemplateExecution": {
+      "description": "Variables to execute a GraphQL template with.",
+      "type": "object",
+      "properties": {
+        "variables": {
+          "description": "Values of the variables declared by the query of the template.",
+          "type": "object"
+        },
+        "version": {
+          "description": "If set, the template is only executed if it still has this version.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "GraphQLTemplates": {
+      "description": "A list of GraphQL templates.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLTemplate"
+      }
+    },
     "InvertedIndexConfig": {
       "description": "Configure the inverted index built into Weaviate (default: 60).",
       "type": "object",
@@ -6488,6 +7342,9 @@ func init() {
           },
           "x-omitempty": true
         },
+        "textAnalyzer": {
+          "$ref": "#/definitions/TextAnalyzerConfig"
+        },
         "tokenization": {
           "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
           "type": "string",
@@ -7024,6 +7881,20 @@ func init() {
         }
       ]
     },
+    "TextAnalyzerConfig": {
+      "description": "Normalization applied to the values of a text or text[] property before they are tokenized, both at index and at query time. Applies to ` + "`" + `where` + "`" + ` filters as well as bm25 and hybrid search. Can't be changed once the property has been created.",
+      "type": "object",
+      "properties": {
+        "asciiFold": {
+          "description": "Replace letters with diacritics by their ASCII equivalent (default: false), e.g. ` + "`" + `Łódź` + "`" + ` is indexed as ` + "`" + `Lodz` + "`" + `.",
+          "type": "boolean"
+        },
+        "caseFold": {
+          "description": "Lowercase the values (default: false). Only has an effect for tokenizations which don't lowercase already, i.e. ` + "`" + `whitespace` + "`" + `, ` + "`" + `field` + "`" + ` and the language specific tokenizations.",
+          "type": "boolean"
+        }
+      }
+    },
     "UserApiKey": {
       "type": "object",
       "required": [
@@ -7290,6 +8161,37 @@ func init() {
           "$ref": "#/definitions/GeoCoordinates"
         }
       }
+    },
+    "WriteModeUpdate": {
+      "description": "The write mode of the cluster or of a single collection.",
+      "type": "object",
+      "properties": {
+        "collection": {
+          "description": "Name of the collection, empty for the cluster-wide write mode.",
+          "type": "string"
+        },
+        "mode": {
+          "description": "The write mode, one of ` + "`" + `READ_WRITE` + "`" + `, ` + "`" + `SCHEMA_FROZEN` + "`" + ` or ` + "`" + `READ_ONLY` + "`" + `.",
+          "type": "string"
+        }
+      }
+    },
+    "WriteModes": {
+      "description": "The cluster-wide write mode and the write modes of all collections which are not read-write.",
+      "type": "object",
+      "properties": {
+        "cluster": {
+          "description": "The cluster-wide write mode.",
+          "type": "string"
+        },
+        "collections": {
+          "description": "The write modes of all collections which are not read-write.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
     }
   },
   "parameters": {
@@ -7311,6 +8213,12 @@ func init() {
       "name": "consistency_level",
       "in": "query"
     },
+    "CommonExcludePropertiesParameterQuery": {
+      "type": "string",
+      "description": "Comma-separated list of the properties to leave out of the response, e.g. ` + "`" + `body,summary` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `properties` + "`" + `.",
+      "name": "exclude_properties",
+      "in": "query"
+    },
     "CommonIncludeParameterQuery": {
       "type": "string",
       "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
@@ -7351,6 +8259,12 @@ func init() {
       "name": "output",
       "in": "query"
     },
+    "CommonPropertiesParameterQuery": {
+      "type": "string",
+      "description": "Comma-separated list of the properties to return, e.g. ` + "`" + `title,author` + "`" + `. All other properties are left out of the response. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `exclude_properties` + "`" + `.",
+      "name": "properties",
+      "in": "query"
+    },
     "CommonSortParameterQuery": {
       "type": "string",
       "description": "Name(s) of the property to sort by - e.g. ` + "`" + `city` + "`" + `, or ` + "`" + `country,city` + "`" + `.",
@@ -8920,11 +9834,595 @@ func init() {
                 "$ref": "#/definitions/ObjectsGetResponse"
               }
             }
-          },
-          "400": {
-            "description": "Malformed request.",
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      },
+      "delete": {
+        "description": "Batch delete objects that match a particular filter. \u003cbr/\u003e\u003cbr/\u003eThe request body takes a single ` + "`" + `where` + "`" + ` filter and will delete all objects matched. \u003cbr/\u003e\u003cbr/\u003eNote that there is a limit to the number of objects to be deleted at once using this filter, in order to protect against unexpected memory surges and very-long-running requests. The default limit is 10,000 and may be configured by setting the ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + ` environment variable. \u003cbr/\u003e\u003cbr/\u003eObjects are deleted in the same order that they would be returned in an equivalent Get query. To delete more objects than the limit, run the same query multiple times.",
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Deletes Objects based on a match filter as a batch.",
+        "operationId": "batch.objects.delete",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchDelete"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "$ref": "#/definitions/BatchDeleteResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/batch/references": {
+      "post": {
+        "description": "Batch create cross-references between collections items (objects or objects) in bulk.",
+        "tags": [
+          "batch",
+          "references"
+        ],
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batch.references.create",
+        "parameters": [
+          {
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
+              }
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      }
+    },
+    "/benchmark/datasets": {
+      "post": {
+        "description": "Create a collection with random vectors and properties and start a background job importing its objects. Once all objects are imported without errors, the node keeps the spec of the dataset, so that it can be benchmarked later on. Requires the permission to manage the cluster.",
+        "tags": [
+          "benchmark"
+        ],
+        "summary": "Generate a synthetic dataset.",
+        "operationId": "benchmark.datasets.create",
+        "parameters": [
+          {
+            "description": "The dataset to generate.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BenchmarkDataset"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The collection was created and the import started.",
+            "schema": {
+              "$ref": "#/definitions/BenchmarkJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.benchmark.datasets.create"
+        ]
+      }
+    },
+    "/benchmark/jobs/{id}": {
+      "get": {
+        "description": "Returns the status of a dataset or benchmark job and its results once it has finished. Jobs are only known to the node that started them.",
+        "tags": [
+          "benchmark"
+        ],
+        "summary": "Get the status of a benchmark job.",
+        "operationId": "benchmark.jobs.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of the job.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The job was found.",
+            "schema": {
+              "$ref": "#/definitions/BenchmarkJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - the job does not exist on this node"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.benchmark.jobs.get"
+        ]
+      }
+    },
+    "/benchmark/runs": {
+      "post": {
+        "description": "Start a background job running queries against a synthetic dataset that was generated completely by this node. Once finished, the job reports their latency, throughput and, if requested, recall. Requires the permission to manage the cluster.",
+        "tags": [
+          "benchmark"
+        ],
+        "summary": "Benchmark queries against a synthetic dataset.",
+        "operationId": "benchmark.runs.create",
+        "parameters": [
+          {
+            "description": "The benchmark to run.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BenchmarkRun"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The benchmark started.",
+            "schema": {
+              "$ref": "#/definitions/BenchmarkJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.benchmark.runs.create"
+        ]
+      }
+    },
+    "/classifications/": {
+      "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
+        "parameters": [
+          {
+            "description": "parameters to start a classification",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Successfully started classification.",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.post"
+        ]
+      }
+    },
+    "/classifications/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created classification",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "classification id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the classification, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Classification does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.get"
+        ]
+      }
+    },
+    "/cluster/statistics": {
+      "get": {
+        "description": "Returns Raft cluster statistics of Weaviate DB.",
+        "tags": [
+          "cluster"
+        ],
+        "summary": "See Raft cluster statistics",
+        "operationId": "cluster.get.statistics",
+        "responses": {
+          "200": {
+            "description": "Cluster statistics successfully returned",
+            "schema": {
+              "$ref": "#/definitions/ClusterStatisticsResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration status attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.cluster.statistics.get"
+        ]
+      }
+    },
+    "/cluster/write-modes": {
+      "get": {
+        "description": "Returns the cluster-wide write mode and the write modes of all collections which are not read-write.",
+        "tags": [
+          "cluster"
+        ],
+        "summary": "See the write modes.",
+        "operationId": "cluster.get.write.modes",
+        "responses": {
+          "200": {
+            "description": "The write modes.",
+            "schema": {
+              "$ref": "#/definitions/WriteModes"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.cluster.write.modes.get"
+        ]
+      },
+      "put": {
+        "description": "Set the cluster-wide write mode, or the write mode of a single collection. ` + "`" + `READ_ONLY` + "`" + ` rejects schema changes and data writes, ` + "`" + `SCHEMA_FROZEN` + "`" + ` only rejects schema changes and ` + "`" + `READ_WRITE` + "`" + ` accepts both. The stricter of the cluster-wide and the collection mode applies.",
+        "tags": [
+          "cluster"
+        ],
+        "summary": "Switch a write mode.",
+        "operationId": "cluster.update.write.mode",
+        "parameters": [
+          {
+            "description": "The write mode to set.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/WriteModeUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The write mode was switched, returns all write modes.",
+            "schema": {
+              "$ref": "#/definitions/WriteModes"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.cluster.write.modes.update"
+        ]
+      }
+    },
+    "/graphql": {
+      "post": {
+        "description": "Get a response based on a GraphQL query",
+        "tags": [
+          "graphql"
+        ],
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
+        "parameters": [
+          {
+            "description": "The GraphQL query request parameters.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQuery"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/GraphQLResponse"
             }
           },
           "401": {
@@ -8942,6 +10440,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "429": {
+            "description": "None of the queries were admitted by their QoS class, because too many queries are running or queued. Retry it later.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -8952,50 +10456,37 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
-      },
-      "delete": {
-        "description": "Batch delete objects that match a particular filter. \u003cbr/\u003e\u003cbr/\u003eThe request body takes a single ` + "`" + `where` + "`" + ` filter and will delete all objects matched. \u003cbr/\u003e\u003cbr/\u003eNote that there is a limit to the number of objects to be deleted at once using this filter, in order to protect against unexpected memory surges and very-long-running requests. The default limit is 10,000 and may be configured by setting the ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + ` environment variable. \u003cbr/\u003e\u003cbr/\u003eObjects are deleted in the same order that they would be returned in an equivalent Get query. To delete more objects than the limit, run the same query multiple times.",
+      }
+    },
+    "/graphql/batch": {
+      "post": {
+        "description": "Perform a batched GraphQL query",
         "tags": [
-          "batch",
-          "objects"
+          "graphql"
         ],
-        "summary": "Deletes Objects based on a match filter as a batch.",
-        "operationId": "batch.objects.delete",
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
         "parameters": [
           {
+            "description": "The GraphQL queries.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BatchDelete"
+              "$ref": "#/definitions/GraphQLQueries"
             }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
-            "schema": {
-              "$ref": "#/definitions/BatchDeleteResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/GraphQLResponses"
             }
           },
           "401": {
@@ -9023,53 +10514,26 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/batch/references": {
-      "post": {
-        "description": "Batch create cross-references between collections items (objects or objects) in bulk.",
+    "/graphql/templates": {
+      "get": {
+        "description": "List the query templates the user may execute, users who may manage templates get all of them",
         "tags": [
-          "batch",
-          "references"
-        ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batch.references.create",
-        "parameters": [
-          {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
-            }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          }
+          "graphql"
         ],
+        "summary": "List the query templates.",
+        "operationId": "graphql.templates.list",
         "responses": {
           "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/GraphQLTemplates"
             }
           },
           "401": {
@@ -9097,40 +10561,32 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.query"
         ]
-      }
-    },
-    "/classifications/": {
+      },
       "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "description": "Create a query template, which gets its first version. Requires permissions to update the cluster.",
         "tags": [
-          "classifications"
+          "graphql"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "summary": "Create a query template.",
+        "operationId": "graphql.templates.create",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
+            "description": "The template to create.",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/GraphQLTemplate"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Successfully started classification.",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
+            "description": "Template created.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/GraphQLTemplate"
             }
           },
           "401": {
@@ -9142,6 +10598,18 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "409": {
+            "description": "A template with this name already exists.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -9149,33 +10617,44 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.classifications.post"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/classifications/{id}": {
-      "get": {
-        "description": "Get status, results and metadata of a previously created classification",
+    "/graphql/templates/{name}": {
+      "put": {
+        "description": "Replace a query template, which gets a new version. Requires permissions to update the cluster.",
         "tags": [
-          "classifications"
+          "graphql"
         ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
+        "summary": "Update a query template.",
+        "operationId": "graphql.templates.update",
         "parameters": [
           {
             "type": "string",
-            "description": "classification id",
-            "name": "id",
+            "description": "Name of the template.",
+            "name": "name",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "The new template.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLTemplate"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the classification, returned as body",
+            "description": "Template updated.",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/GraphQLTemplate"
             }
           },
           "401": {
@@ -9188,46 +10667,19 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Classification does not exist"
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "Template not found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          }
-        },
-        "x-serviceIds": [
-          "weaviate.classifications.get"
-        ]
-      }
-    },
-    "/cluster/statistics": {
-      "get": {
-        "description": "Returns Raft cluster statistics of Weaviate DB.",
-        "tags": [
-          "cluster"
-        ],
-        "summary": "See Raft cluster statistics",
-        "operationId": "cluster.get.statistics",
-        "responses": {
-          "200": {
-            "description": "Cluster statistics successfully returned",
-            "schema": {
-              "$ref": "#/definitions/ClusterStatisticsResponse"
-            }
           },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
+          "409": {
+            "description": "The template changed since the given version.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9239,27 +10691,34 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.cluster.statistics.get"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/graphql": {
+      },
       "post": {
-        "description": "Get a response based on a GraphQL query",
+        "description": "Execute a stored GraphQL template by name with the given variables",
         "tags": [
           "graphql"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
+        "summary": "Execute a GraphQL template.",
+        "operationId": "graphql.templates.execute",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
+            "type": "string",
+            "description": "Name of the template.",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The variables of the template.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
+              "$ref": "#/definitions/GraphQLTemplateExecution"
             }
           }
         ],
@@ -9279,12 +10738,24 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Template not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "429": {
+            "description": "None of the queries were admitted by their QoS class, because too many queries are running or queued. Retry it later.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -9295,38 +10766,28 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.query"
         ]
-      }
-    },
-    "/graphql/batch": {
-      "post": {
-        "description": "Perform a batched GraphQL query",
+      },
+      "delete": {
+        "description": "Delete a query template by name. Requires permissions to update the cluster.",
         "tags": [
           "graphql"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "summary": "Delete a query template.",
+        "operationId": "graphql.templates.delete",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
-            }
+            "type": "string",
+            "description": "Name of the template.",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful query (with select).",
-            "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
-            }
+          "204": {
+            "description": "Template deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -9336,9 +10797,9 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+          },
+          "404": {
+            "description": "Template not found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9353,10 +10814,7 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.manipulate"
         ]
       }
     },
@@ -9554,6 +11012,18 @@ func init() {
             "name": "include",
             "in": "query"
           },
+          {
+            "type": "string",
+            "description": "Comma-separated list of the properties to return, e.g. ` + "`" + `title,author` + "`" + `. All other properties are left out of the response. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `exclude_properties` + "`" + `.",
+            "name": "properties",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of the properties to leave out of the response, e.g. ` + "`" + `body,summary` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `properties` + "`" + `.",
+            "name": "exclude_properties",
+            "in": "query"
+          },
           {
             "type": "string",
             "description": "Name(s) of the property to sort by - e.g. ` + "`" + `city` + "`" + `, or ` + "`" + `country,city` + "`" + `.",
@@ -9768,6 +11238,18 @@ func init() {
             "name": "include",
             "in": "query"
           },
+          {
+            "type": "string",
+            "description": "Comma-separated list of the properties to return, e.g. ` + "`" + `title,author` + "`" + `. All other properties are left out of the response. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `exclude_properties` + "`" + `.",
+            "name": "properties",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of the properties to leave out of the response, e.g. ` + "`" + `body,summary` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `properties` + "`" + `.",
+            "name": "exclude_properties",
+            "in": "query"
+          },
           {
             "type": "string",
             "description": "Determines how many replicas must acknowledge a request before it is considered successful",
@@ -10437,6 +11919,18 @@ func init() {
             "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
             "name": "include",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of the properties to return, e.g. ` + "`" + `title,author` + "`" + `. All other properties are left out of the response. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `exclude_properties` + "`" + `.",
+            "name": "properties",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of the properties to leave out of the response, e.g. ` + "`" + `body,summary` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `properties` + "`" + `.",
+            "name": "exclude_properties",
+            "in": "query"
           }
         ],
         "responses": {
@@ -12805,6 +14299,242 @@ func init() {
         }
       }
     },
+    "BenchmarkDataset": {
+      "description": "A synthetic collection for benchmarks. Generating the same dataset twice results in exactly the same objects and vectors.",
+      "type": "object",
+      "properties": {
+        "batchSize": {
+          "description": "Number of objects imported per batch. Defaults to 1000.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "collection": {
+          "description": "Name of the collection to create.",
+          "type": "string"
+        },
+        "dimensions": {
+          "description": "Number of dimensions of the random vectors.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "distance": {
+          "description": "Distance metric of the vector index, one of ` + "`" + `cosine` + "`" + ` (default), ` + "`" + `dot` + "`" + ` or ` + "`" + `l2-squared` + "`" + `.",
+          "type": "string"
+        },
+        "objects": {
+          "description": "Number of objects to generate.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "properties": {
+          "description": "Properties of the generated objects.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BenchmarkDatasetProperty"
+          }
+        },
+        "seed": {
+          "description": "Seed of the random values.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "BenchmarkDatasetProperty": {
+      "description": "How the values of a synthetic property are distributed.",
+      "type": "object",
+      "properties": {
+        "cardinality": {
+          "description": "Number of distinct values of ` + "`" + `text` + "`" + ` and ` + "`" + `int` + "`" + ` properties. Defaults to 100.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "dataType": {
+          "description": "Data type of the property, one of ` + "`" + `text` + "`" + `, ` + "`" + `int` + "`" + `, ` + "`" + `number` + "`" + ` or ` + "`" + `boolean` + "`" + `.",
+          "type": "string"
+        },
+        "distribution": {
+          "description": "Distribution of the values, ` + "`" + `uniform` + "`" + ` (default), ` + "`" + `zipf` + "`" + ` for ` + "`" + `text` + "`" + ` and ` + "`" + `int` + "`" + ` properties or ` + "`" + `normal` + "`" + ` for ` + "`" + `number` + "`" + ` properties.",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the property.",
+          "type": "string"
+        }
+      }
+    },
+    "BenchmarkDatasetReport": {
+      "description": "Summary of the import of a synthetic dataset.",
+      "type": "object",
+      "properties": {
+        "collection": {
+          "description": "Name of the created collection.",
+          "type": "string"
+        },
+        "durationMs": {
+          "description": "Duration of the import in milliseconds.",
+          "type": "number"
+        },
+        "errors": {
+          "description": "Number of objects that could not be imported.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objects": {
+          "description": "Number of imported objects.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsPerSecond": {
+          "description": "Imported objects per second.",
+          "type": "number"
+        }
+      }
+    },
+    "BenchmarkJob": {
+      "description": "A background job generating a synthetic dataset or running a benchmark.",
+      "type": "object",
+      "properties": {
+        "collection": {
+          "description": "Name of the collection of the job.",
+          "type": "string"
+        },
+        "datasetReport": {
+          "description": "Summary of the import, set for ` + "`" + `dataset` + "`" + ` jobs.",
+          "$ref": "#/definitions/BenchmarkDatasetReport"
+        },
+        "error": {
+          "description": "Why the job failed.",
+          "type": "string"
+        },
+        "id": {
+          "description": "ID of the job.",
+          "type": "string"
+        },
+        "runReport": {
+          "description": "Results of the benchmark, set for finished ` + "`" + `run` + "`" + ` jobs.",
+          "$ref": "#/definitions/BenchmarkRunReport"
+        },
+        "status": {
+          "description": "Status of the job, one of ` + "`" + `STARTED` + "`" + `, ` + "`" + `SUCCESS` + "`" + ` or ` + "`" + `FAILED` + "`" + `.",
+          "type": "string"
+        },
+        "type": {
+          "description": "Type of the job, ` + "`" + `dataset` + "`" + ` or ` + "`" + `run` + "`" + `.",
+          "type": "string"
+        }
+      }
+    },
+    "BenchmarkLatency": {
+      "description": "Query latencies in milliseconds.",
+      "type": "object",
+      "properties": {
+        "max": {
+          "description": "Slowest query.",
+          "type": "number"
+        },
+        "mean": {
+          "description": "Mean latency.",
+          "type": "number"
+        },
+        "p50": {
+          "description": "50th percentile.",
+          "type": "number"
+        },
+        "p90": {
+          "description": "90th percentile.",
+          "type": "number"
+        },
+        "p99": {
+          "description": "99th percentile.",
+          "type": "number"
+        }
+      }
+    },
+    "BenchmarkRun": {
+      "description": "A query benchmark against a synthetic dataset.",
+      "type": "object",
+      "properties": {
+        "collection": {
+          "description": "Name of a collection created as a synthetic dataset.",
+          "type": "string"
+        },
+        "concurrency": {
+          "description": "Number of queries sent in parallel. Defaults to 1.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "limit": {
+          "description": "Number of results per query. Defaults to 10.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "queries": {
+          "description": "Number of queries to run. Defaults to 100.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "queryType": {
+          "description": "Type of the queries, ` + "`" + `nearVector` + "`" + ` (default) or ` + "`" + `bm25` + "`" + `.",
+          "type": "string"
+        },
+        "recall": {
+          "description": "Compare the results of ` + "`" + `nearVector` + "`" + ` queries with an exact brute force search over the dataset.",
+          "type": "boolean"
+        },
+        "seed": {
+          "description": "Seed of the random query vectors and terms.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "BenchmarkRunReport": {
+      "description": "Latency, throughput and recall of a benchmark run.",
+      "type": "object",
+      "properties": {
+        "collection": {
+          "description": "Name of the benchmarked collection.",
+          "type": "string"
+        },
+        "concurrency": {
+          "description": "Number of queries sent in parallel.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "durationMs": {
+          "description": "Duration of the run in milliseconds.",
+          "type": "number"
+        },
+        "errors": {
+          "description": "Number of failed queries.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "latencyMs": {
+          "description": "Latencies of the successful queries.",
+          "$ref": "#/definitions/BenchmarkLatency"
+        },
+        "queries": {
+          "description": "Number of queries.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "queryType": {
+          "description": "Type of the queries.",
+          "type": "string"
+        },
+        "recall": {
+          "description": "Mean recall of the queries, only set if requested.",
+          "type": "number",
+          "x-nullable": true
+        },
+        "throughputQPS": {
+          "description": "Successful queries per second.",
+          "type": "number"
+        }
+      }
+    },
     "C11yExtension": {
       "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
       "properties": {
@@ -13422,6 +15152,62 @@ func init() {
         "$ref": "#/definitions/GraphQLResponse"
       }
     },
+    "GraphQLTemplate": {
+      "description": "A named, parameterized query stored in the schema. It holds either a GraphQL query, which is executed through this API, or a search request of the gRPC API, which is executed through its ExecuteTemplate method.",
+      "type": "object",
+      "properties": {
+        "description": {
+          "description": "What the template is used for.",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name the template is executed by.",
+          "type": "string"
+        },
+        "query": {
+          "description": "GraphQL query of the template. Its variables are set when the template is executed. Only one of query and search may be set.",
+          "type": "string"
+        },
+        "roles": {
+          "description": "Roles which may execute the template. If empty, everyone may execute it.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "search": {
+          "description": "Search request of the gRPC API (weaviate.v1.SearchRequest) in its JSON representation. String values of the form \"$name\" are variables, which are replaced by their values when the template is executed. Strings starting with \"$$\" stand for the same string with a single leading \"$\". Only one of query and search may be set.",
+          "type": "object"
+        },
+        "version": {
+          "description": "Version of the template, set by the server whenever the template changes. It is ignored when a template is created. When a template is updated, the update is only applied if the template still has this version, unless it is 0.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "GraphQLTemplateExecution": {
+      "description": "Variables to execute a GraphQL template with.",
+      "type": "object",
+      "properties": {
+        "variables": {
+          "description": "Values of the variables declared by the query of the template.",
+          "type": "object"
+        },
+        "version": {
+          "description": "If set, the template is only executed if it still has this version.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "GraphQLTemplates": {
+      "description": "A list of GraphQL templates.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLTemplate"
+      }
+    },
     "InvertedIndexConfig": {
       "description": "Configure the inverted index built into Weaviate (default: 60).",
       "type": "object",
@@ -14281,6 +16067,9 @@ func init() {
           },
           "x-omitempty": true
         },
+        "textAnalyzer": {
+          "$ref": "#/definitions/TextAnalyzerConfig"
+        },
         "tokenization": {
           "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
           "type": "string",
@@ -14817,6 +16606,20 @@ func init() {
         }
       ]
     },
+    "TextAnalyzerConfig": {
+      "description": "Normalization applied to the values of a text or text[] property before they are tokenized, both at index and at query time. Applies to ` + "`" + `where` + "`" + ` filters as well as bm25 and hybrid search. Can't be changed once the property has been created.",
+      "type": "object",
+      "properties": {
+        "asciiFold": {
+          "description": "Replace letters with diacritics by their ASCII equivalent (default: false), e.g. ` + "`" + `Łódź` + "`" + ` is indexed as ` + "`" + `Lodz` + "`" + `.",
+          "type": "boolean"
+        },
+        "caseFold": {
+          "description": "Lowercase the values (default: false). Only has an effect for tokenizations which don't lowercase already, i.e. ` + "`" + `whitespace` + "`" + `, ` + "`" + `field` + "`" + ` and the language specific tokenizations.",
+          "type": "boolean"
+        }
+      }
+    },
     "UserApiKey": {
       "type": "object",
       "required": [
@@ -15092,6 +16895,37 @@ func init() {
           "format": "float64"
         }
       }
+    },
+    "WriteModeUpdate": {
+      "description": "The write mode of the cluster or of a single collection.",
+      "type": "object",
+      "properties": {
+        "collection": {
+          "description": "Name of the collection, empty for the cluster-wide write mode.",
+          "type": "string"
+        },
+        "mode": {
+          "description": "The write mode, one of ` + "`" + `READ_WRITE` + "`" + `, ` + "`" + `SCHEMA_FROZEN` + "`" + ` or ` + "`" + `READ_ONLY` + "`" + `.",
+          "type": "string"
+        }
+      }
+    },
+    "WriteModes": {
+      "description": "The cluster-wide write mode and the write modes of all collections which are not read-write.",
+      "type": "object",
+      "properties": {
+        "cluster": {
+          "description": "The cluster-wide write mode.",
+          "type": "string"
+        },
+        "collections": {
+          "description": "The write modes of all collections which are not read-write.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
     }
   },
   "parameters": {
@@ -15113,6 +16947,12 @@ func init() {
       "name": "consistency_level",
       "in": "query"
     },
+    "CommonExcludePropertiesParameterQuery": {
+      "type": "string",
+      "description": "Comma-separated list of the properties to leave out of the response, e.g. ` + "`" + `body,summary` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `properties` + "`" + `.",
+      "name": "exclude_properties",
+      "in": "query"
+    },
     "CommonIncludeParameterQuery": {
       "type": "string",
       "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
@@ -15153,6 +16993,12 @@ func init() {
       "name": "output",
       "in": "query"
     },
+    "CommonPropertiesParameterQuery": {
+      "type": "string",
+      "description": "Comma-separated list of the properties to return, e.g. ` + "`" + `title,author` + "`" + `. All other properties are left out of the response. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `exclude_properties` + "`" + `.",
+      "name": "properties",
+      "in": "query"
+    },
     "CommonSortParameterQuery": {
       "type": "string",
       "description": "Name(s) of the property to sort by - e.g. ` + "`" + `city` + "`" + `, or ` + "`" + `country,city` + "`" + `.",
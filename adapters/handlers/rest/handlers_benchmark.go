@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+
+	restCtx "github.com/weaviate/weaviate/adapters/handlers/rest/context"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/benchmark"
+	"github.com/weaviate/weaviate/entities/models"
+	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	ucbenchmark "github.com/weaviate/weaviate/usecases/benchmark"
+)
+
+// setupBenchmarkHandlers exposes the synthetic data and benchmark harness.
+// Generating a dataset and running a benchmark start background jobs, whose
+// state and results are polled through the jobs endpoint.
+func setupBenchmarkHandlers(api *operations.WeaviateAPI, harness *ucbenchmark.Harness,
+	logger logrus.FieldLogger,
+) {
+	api.BenchmarkBenchmarkDatasetsCreateHandler = benchmark.BenchmarkDatasetsCreateHandlerFunc(func(params benchmark.BenchmarkDatasetsCreateParams, principal *models.Principal) middleware.Responder {
+		ctx := restCtx.AddPrincipalToContext(params.HTTPRequest.Context(), principal)
+		job, err := harness.Generate(ctx, principal, datasetSpecFromModel(params.Body))
+		if err != nil {
+			logger.WithField("action", "benchmark_generate").WithError(err).Error("generate synthetic dataset")
+			if errors.As(err, &authzerrors.Forbidden{}) {
+				return benchmark.NewBenchmarkDatasetsCreateForbidden().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+			return benchmark.NewBenchmarkDatasetsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+
+		return benchmark.NewBenchmarkDatasetsCreateAccepted().WithPayload(benchmarkJobToModel(job))
+	})
+
+	api.BenchmarkBenchmarkRunsCreateHandler = benchmark.BenchmarkRunsCreateHandlerFunc(func(params benchmark.BenchmarkRunsCreateParams, principal *models.Principal) middleware.Responder {
+		ctx := restCtx.AddPrincipalToContext(params.HTTPRequest.Context(), principal)
+		job, err := harness.Run(ctx, principal, ucbenchmark.RunSpec{
+			Collection:  params.Body.Collection,
+			QueryType:   params.Body.QueryType,
+			Queries:     int(params.Body.Queries),
+			Concurrency: int(params.Body.Concurrency),
+			Limit:       int(params.Body.Limit),
+			Recall:      params.Body.Recall,
+			Seed:        params.Body.Seed,
+		})
+		if err != nil {
+			logger.WithField("action", "benchmark_run").WithError(err).Error("run benchmark")
+			if errors.As(err, &authzerrors.Forbidden{}) {
+				return benchmark.NewBenchmarkRunsCreateForbidden().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+			return benchmark.NewBenchmarkRunsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+
+		return benchmark.NewBenchmarkRunsCreateAccepted().WithPayload(benchmarkJobToModel(job))
+	})
+
+	api.BenchmarkBenchmarkJobsGetHandler = benchmark.BenchmarkJobsGetHandlerFunc(func(params benchmark.BenchmarkJobsGetParams, principal *models.Principal) middleware.Responder {
+		ctx := restCtx.AddPrincipalToContext(params.HTTPRequest.Context(), principal)
+		job, err := harness.Job(ctx, principal, params.ID)
+		if err != nil {
+			switch {
+			case errors.As(err, &authzerrors.Forbidden{}):
+				return benchmark.NewBenchmarkJobsGetForbidden().
+					WithPayload(errPayloadFromSingleErr(err))
+			case errors.Is(err, ucbenchmark.ErrJobNotFound):
+				return benchmark.NewBenchmarkJobsGetNotFound()
+			default:
+				return benchmark.NewBenchmarkJobsGetInternalServerError().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+		}
+
+		return benchmark.NewBenchmarkJobsGetOK().WithPayload(benchmarkJobToModel(job))
+	})
+}
+
+func benchmarkJobToModel(job *ucbenchmark.Job) *models.BenchmarkJob {
+	m := &models.BenchmarkJob{
+		Collection: job.Collection,
+		Error:      job.Error,
+		ID:         job.ID,
+		Status:     job.Status,
+		Type:       job.Type,
+	}
+
+	if report := job.Dataset; report != nil {
+		m.DatasetReport = &models.BenchmarkDatasetReport{
+			Collection:       report.Collection,
+			DurationMs:       report.DurationMs,
+			Errors:           int64(report.Errors),
+			Objects:          int64(report.Objects),
+			ObjectsPerSecond: report.ObjectsPerSec,
+		}
+	}
+
+	if report := job.Run; report != nil {
+		m.RunReport = &models.BenchmarkRunReport{
+			Collection:  report.Collection,
+			Concurrency: int64(report.Concurrency),
+			DurationMs:  report.DurationMs,
+			Errors:      int64(report.Errors),
+			LatencyMs: &models.BenchmarkLatency{
+				Max:  report.Latency.Max,
+				Mean: report.Latency.Mean,
+				P50:  report.Latency.P50,
+				P90:  report.Latency.P90,
+				P99:  report.Latency.P99,
+			},
+			Queries:       int64(report.Queries),
+			QueryType:     report.QueryType,
+			Recall:        report.Recall,
+			ThroughputQPS: report.ThroughputQPS,
+		}
+	}
+
+	return m
+}
+
+func datasetSpecFromModel(m *models.BenchmarkDataset) ucbenchmark.DatasetSpec {
+	props := make([]ucbenchmark.PropertySpec, 0, len(m.Properties))
+	for _, p := range m.Properties {
+		if p == nil {
+			continue
+		}
+		props = append(props, ucbenchmark.PropertySpec{
+			Name:         p.Name,
+			DataType:     p.DataType,
+			Distribution: p.Distribution,
+			Cardinality:  int(p.Cardinality),
+		})
+	}
+
+	return ucbenchmark.DatasetSpec{
+		Collection: m.Collection,
+		Objects:    int(m.Objects),
+		Dimensions: int(m.Dimensions),
+		Distance:   m.Distance,
+		Properties: props,
+		Seed:       m.Seed,
+		BatchSize:  int(m.BatchSize),
+	}
+}
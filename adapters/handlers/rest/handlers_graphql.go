@@ -16,9 +16,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"runtime"
 	"strconv"
 	"strings"
-	"sync"
 
 	middleware "github.com/go-openapi/runtime/middleware"
 	"github.com/sirupsen/logrus"
@@ -34,16 +34,12 @@ import (
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/qos"
 	"github.com/weaviate/weaviate/usecases/schema"
 )
 
 const error422 string = "The request is well-formed but was unable to be followed due to semantic errors."
 
-type gqlUnbatchedRequestResponse struct {
-	RequestIndex int
-	Response     *models.GraphQLResponse
-}
-
 type graphQLProvider interface {
 	GetGraphQL() libgraphql.GraphQL
 }
@@ -53,6 +49,7 @@ func setupGraphQLHandlers(
 	gqlProvider graphQLProvider,
 	m *schema.Manager,
 	disabled bool,
+	maxBatchSize int,
 	metrics *monitoring.PrometheusMetrics,
 	logger logrus.FieldLogger,
 ) {
@@ -149,29 +146,52 @@ func setupGraphQLHandlers(
 		}
 
 		metricRequestsTotal.log(result)
+		if payload, ok := rateLimitedPayload(result); ok {
+			return graphql.NewGraphqlPostTooManyRequests().WithPayload(payload)
+		}
 		// Return the response
 		return graphql.NewGraphqlPostOK().WithPayload(graphQLResponse)
 	})
 
+	// Batched requests are sent either to /graphql/batch or as an array to
+	// /graphql, see addGraphQLBatchRouting. The operations are resolved
+	// concurrently, up to one per CPU, and each one gets its own response, so
+	// that a failing operation doesn't fail the whole batch.
 	api.GraphqlGraphqlBatchHandler = graphql.GraphqlBatchHandlerFunc(func(params graphql.GraphqlBatchParams, principal *models.Principal) middleware.Responder {
-		// this is barely used (if at all) - so require read access to all collections for data and metadata
-		err := m.Authorizer.Authorize(principal, authorization.READ, authorization.Collections()...)
+		// same as for single requests, every operation is authorized on its own
+		// when it's resolved
+		err := m.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata()...)
 		if err != nil {
-			return graphql.NewGraphqlBatchForbidden().WithPayload(errPayloadFromSingleErr(err))
+			metricRequestsTotal.logUserError()
+			switch {
+			case errors.As(err, &authzerrors.Forbidden{}):
+				return graphql.NewGraphqlBatchForbidden().
+					WithPayload(errPayloadFromSingleErr(
+						fmt.Errorf("due to GraphQL introspection, this role must have the permission to `read_collections` on `*` (all) collections: %w", err),
+					))
+			default:
+				return graphql.NewGraphqlBatchUnprocessableEntity().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
 		}
 
-		errorResponse := &models.ErrorResponse{}
-		amountOfBatchedRequests := len(params.Body)
-		if amountOfBatchedRequests == 0 {
+		if disabled {
 			metricRequestsTotal.logUserError()
-			return graphql.NewGraphqlBatchUnprocessableEntity().WithPayload(errorResponse)
+			return graphql.NewGraphqlBatchUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf("graphql api is disabled")))
 		}
-		requestResults := make(chan gqlUnbatchedRequestResponse, amountOfBatchedRequests)
-
-		wg := new(sync.WaitGroup)
 
-		ctx := params.HTTPRequest.Context()
-		ctx = context.WithValue(ctx, "principal", principal)
+		if len(params.Body) == 0 {
+			metricRequestsTotal.logUserError()
+			return graphql.NewGraphqlBatchUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf("batch cannot be empty")))
+		}
+		if maxBatchSize > 0 && len(params.Body) > maxBatchSize {
+			metricRequestsTotal.logUserError()
+			return graphql.NewGraphqlBatchUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf(
+					"batch contains %d operations, at most %d are allowed", len(params.Body), maxBatchSize)))
+		}
 
 		graphQL := gqlProvider.GetGraphQL()
 		if graphQL == nil {
@@ -181,114 +201,70 @@ func setupGraphQLHandlers(
 			return graphql.NewGraphqlBatchUnprocessableEntity().WithPayload(errRes)
 		}
 
-		// Generate a goroutine for each separate request
-		for requestIndex, unbatchedRequest := range params.Body {
-			requestIndex, unbatchedRequest := requestIndex, unbatchedRequest
-			wg.Add(1)
-			enterrors.GoWrapper(func() {
-				handleUnbatchedGraphQLRequest(ctx, wg, graphQL, unbatchedRequest, requestIndex, &requestResults, metricRequestsTotal)
-			}, logger)
-		}
-
-		wg.Wait()
-
-		close(requestResults)
-
-		batchedRequestResponse := make([]*models.GraphQLResponse, amountOfBatchedRequests)
+		ctx := restCtx.AddPrincipalToContext(params.HTTPRequest.Context(), principal)
 
-		// Add the requests to the result array in the correct order
-		for unbatchedRequestResult := range requestResults {
-			batchedRequestResponse[unbatchedRequestResult.RequestIndex] = unbatchedRequestResult.Response
+		// every goroutine writes to its own index, so the responses are in the
+		// order of the requests
+		responses := make([]*models.GraphQLResponse, len(params.Body))
+		eg := enterrors.NewErrorGroupWrapper(logger)
+		eg.SetLimit(runtime.GOMAXPROCS(0))
+		for i, request := range params.Body {
+			i, request := i, request
+			eg.Go(func() error {
+				responses[i] = resolveUnbatchedGraphQLRequest(ctx, graphQL, request, metricRequestsTotal)
+				return nil
+			})
+		}
+		if err := eg.Wait(); err != nil {
+			metricRequestsTotal.logServerError(err, "", "")
+			return graphql.NewGraphqlBatchInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
 		}
 
-		return graphql.NewGraphqlBatchOK().WithPayload(batchedRequestResponse)
+		return graphql.NewGraphqlBatchOK().WithPayload(responses)
 	})
 }
 
-// Handle a single unbatched GraphQL request, return a tuple containing the index of the request in the batch and either the response or an error
-func handleUnbatchedGraphQLRequest(ctx context.Context, wg *sync.WaitGroup, graphQL libgraphql.GraphQL, unbatchedRequest *models.GraphQLQuery, requestIndex int, requestResults *chan gqlUnbatchedRequestResponse, metricRequestsTotal *graphqlRequestsTotal) {
-	defer wg.Done()
-
-	// Get all input from the body of the request
-	query := unbatchedRequest.Query
-	operationName := unbatchedRequest.OperationName
-	graphQLResponse := &models.GraphQLResponse{}
-
-	// Return an unprocessable error if the query is empty
-	if query == "" {
+// resolveUnbatchedGraphQLRequest resolves a single request of a batch. Errors
+// can't be returned as status codes for batched requests, so they are
+// returned as errors of the GraphQL response instead.
+func resolveUnbatchedGraphQLRequest(ctx context.Context, graphQL libgraphql.GraphQL,
+	request *models.GraphQLQuery, metricRequestsTotal *graphqlRequestsTotal,
+) *models.GraphQLResponse {
+	errorResponse := func(msg string) *models.GraphQLResponse {
 		metricRequestsTotal.logUserError()
-		// Regular error messages are returned as an error code in the request header, but that doesn't work for batched requests
 		errorCode := strconv.Itoa(graphql.GraphqlBatchUnprocessableEntityCode)
-		errorMessage := fmt.Sprintf("%s: %s", errorCode, error422)
-		errors := []*models.GraphQLError{{Message: errorMessage}}
-		graphQLResponse := models.GraphQLResponse{Data: nil, Errors: errors}
-		*requestResults <- gqlUnbatchedRequestResponse{
-			requestIndex,
-			&graphQLResponse,
-		}
-	} else {
-		// Extract any variables from the request
-		var variables map[string]interface{}
-		if unbatchedRequest.Variables != nil {
-			var ok bool
-			variables, ok = unbatchedRequest.Variables.(map[string]interface{})
-			if !ok {
-				errorCode := strconv.Itoa(graphql.GraphqlBatchUnprocessableEntityCode)
-				errorMessage := fmt.Sprintf("%s: %s", errorCode, fmt.Sprintf("expected map[string]interface{}, received %v", unbatchedRequest.Variables))
-
-				error := []*models.GraphQLError{{Message: errorMessage}}
-				graphQLResponse := models.GraphQLResponse{Data: nil, Errors: error}
-				*requestResults <- gqlUnbatchedRequestResponse{
-					requestIndex,
-					&graphQLResponse,
-				}
-				return
-			}
+		return &models.GraphQLResponse{
+			Errors: []*models.GraphQLError{{Message: fmt.Sprintf("%s: %s", errorCode, msg)}},
 		}
+	}
 
-		result := graphQL.Resolve(ctx, query, operationName, variables)
-
-		// Marshal the JSON
-		resultJSON, jsonErr := json.Marshal(result)
+	if request == nil || request.Query == "" {
+		return errorResponse("query cannot be empty")
+	}
 
-		// Return an unprocessable error if marshalling the result to JSON failed
-		if jsonErr != nil {
-			metricRequestsTotal.logUserError()
-			// Regular error messages are returned as an error code in the request header, but that doesn't work for batched requests
-			errorCode := strconv.Itoa(graphql.GraphqlBatchUnprocessableEntityCode)
-			errorMessage := fmt.Sprintf("%s: %s", errorCode, error422)
-			errors := []*models.GraphQLError{{Message: errorMessage}}
-			graphQLResponse := models.GraphQLResponse{Data: nil, Errors: errors}
-			*requestResults <- gqlUnbatchedRequestResponse{
-				requestIndex,
-				&graphQLResponse,
-			}
-		} else {
-			// Put the result data in a response ready object
-			marshallErr := json.Unmarshal(resultJSON, graphQLResponse)
-
-			// Return an unprocessable error if unmarshalling the result to JSON failed
-			if marshallErr != nil {
-				metricRequestsTotal.logUserError()
-				// Regular error messages are returned as an error code in the request header, but that doesn't work for batched requests
-				errorCode := strconv.Itoa(graphql.GraphqlBatchUnprocessableEntityCode)
-				errorMessage := fmt.Sprintf("%s: %s", errorCode, error422)
-				errors := []*models.GraphQLError{{Message: errorMessage}}
-				graphQLResponse := models.GraphQLResponse{Data: nil, Errors: errors}
-				*requestResults <- gqlUnbatchedRequestResponse{
-					requestIndex,
-					&graphQLResponse,
-				}
-			} else {
-				metricRequestsTotal.log(result)
-				// Return the GraphQL response
-				*requestResults <- gqlUnbatchedRequestResponse{
-					requestIndex,
-					graphQLResponse,
-				}
-			}
+	var variables map[string]interface{}
+	if request.Variables != nil {
+		var ok bool
+		variables, ok = request.Variables.(map[string]interface{})
+		if !ok {
+			return errorResponse(fmt.Sprintf("expected map[string]interface{}, received %v", request.Variables))
 		}
 	}
+
+	result := graphQL.Resolve(ctx, request.Query, request.OperationName, variables)
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return errorResponse(error422)
+	}
+	graphQLResponse := &models.GraphQLResponse{}
+	if err := json.Unmarshal(resultJSON, graphQLResponse); err != nil {
+		return errorResponse(error422)
+	}
+
+	metricRequestsTotal.log(result)
+	return graphQLResponse
 }
 
 type graphqlRequestsTotal struct {
@@ -296,6 +272,57 @@ type graphqlRequestsTotal struct {
 	logger  logrus.FieldLogger
 }
 
+// rateLimitedPayload returns the errors of the queries of result that weren't
+// admitted by their QoS class, e.g. because its queue is full. The request
+// only fails with 429, so that clients can retry it later, if no query was
+// resolved and every error is such a rejection. Otherwise the response keeps
+// the data of the resolved queries, like for any other error.
+func rateLimitedPayload(result *tailorincgraphql.Result) (*models.ErrorResponse, bool) {
+	if len(result.Errors) == 0 || hasResolvedData(result.Data) {
+		return nil, false
+	}
+
+	payload := &models.ErrorResponse{}
+	for _, gqlErr := range result.Errors {
+		if !isQoSRejection(gqlErr) {
+			return nil, false
+		}
+		payload.Error = append(payload.Error, &models.ErrorResponseErrorItems0{Message: gqlErr.Message})
+	}
+	return payload, true
+}
+
+// hasResolvedData returns whether any query, e.g. a class of a Get, was
+// resolved. The fields of queries that failed are nil.
+func hasResolvedData(data interface{}) bool {
+	switch d := data.(type) {
+	case nil:
+		return false
+	case map[string]interface{}:
+		for _, v := range d {
+			if hasResolvedData(v) {
+				return true
+			}
+		}
+		return false
+	default:
+		return true
+	}
+}
+
+func isQoSRejection(gqlErr gqlerrors.FormattedError) bool {
+	err := gqlErr.OriginalError()
+	var gqlOriginalErr *gqlerrors.Error
+	if errors.As(err, &gqlOriginalErr) {
+		err = gqlOriginalErr.OriginalError
+	}
+	var gqlFormatted *gqlerrors.FormattedError
+	if errors.As(err, &gqlFormatted) {
+		err = gqlFormatted.OriginalError()
+	}
+	return qos.IsRejected(err)
+}
+
 func newGraphqlRequestsTotal(metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) *graphqlRequestsTotal {
 	return &graphqlRequestsTotal{newRequestsTotalMetric(metrics, "graphql"), logger}
 }
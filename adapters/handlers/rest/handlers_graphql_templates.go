@@ -0,0 +1,310 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+
+	restCtx "github.com/weaviate/weaviate/adapters/handlers/rest/context"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/graphql"
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/querytemplates"
+	"github.com/weaviate/weaviate/usecases/schema"
+)
+
+// setupGraphQLTemplateHandlers exposes the management of query templates and
+// the execution of GraphQL templates. A template only decides which query is
+// run, the query itself is resolved like any other GraphQL request and is
+// authorized with the permissions of the caller.
+func setupGraphQLTemplateHandlers(
+	api *operations.WeaviateAPI,
+	gqlProvider graphQLProvider,
+	m *schema.Manager,
+	templates *querytemplates.Templates,
+	disabled bool,
+	metrics *monitoring.PrometheusMetrics,
+	logger logrus.FieldLogger,
+) {
+	metricRequestsTotal := newGraphqlRequestsTotal(metrics, logger)
+
+	api.GraphqlGraphqlTemplatesListHandler = graphql.GraphqlTemplatesListHandlerFunc(func(params graphql.GraphqlTemplatesListParams, principal *models.Principal) middleware.Responder {
+		err := m.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata()...)
+		if err != nil {
+			switch {
+			case errors.As(err, &authzerrors.Forbidden{}):
+				return graphql.NewGraphqlTemplatesListForbidden().
+					WithPayload(errPayloadFromSingleErr(err))
+			default:
+				return graphql.NewGraphqlTemplatesListUnprocessableEntity().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+		}
+
+		if disabled {
+			return graphql.NewGraphqlTemplatesListUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf("graphql api is disabled")))
+		}
+
+		list := templates.List(principal)
+		payload := make(models.GraphQLTemplates, len(list))
+		for i, t := range list {
+			template, err := templateToModel(t)
+			if err != nil {
+				return graphql.NewGraphqlTemplatesListInternalServerError().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+			payload[i] = template
+		}
+		return graphql.NewGraphqlTemplatesListOK().WithPayload(payload)
+	})
+
+	api.GraphqlGraphqlTemplatesCreateHandler = graphql.GraphqlTemplatesCreateHandlerFunc(func(params graphql.GraphqlTemplatesCreateParams, principal *models.Principal) middleware.Responder {
+		template, err := templateFromModel(params.Body.Name, params.Body)
+		if err != nil {
+			return graphql.NewGraphqlTemplatesCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+
+		created, err := templates.Create(params.HTTPRequest.Context(), principal, template)
+		if err != nil {
+			switch {
+			case errors.As(err, &authzerrors.Forbidden{}):
+				return graphql.NewGraphqlTemplatesCreateForbidden().
+					WithPayload(errPayloadFromSingleErr(err))
+			case errors.Is(err, querytemplates.ErrInvalid):
+				return graphql.NewGraphqlTemplatesCreateUnprocessableEntity().
+					WithPayload(errPayloadFromSingleErr(err))
+			case errors.Is(err, querytemplates.ErrExists):
+				return graphql.NewGraphqlTemplatesCreateConflict().
+					WithPayload(errPayloadFromSingleErr(err))
+			default:
+				return graphql.NewGraphqlTemplatesCreateInternalServerError().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+		}
+
+		payload, err := templateToModel(created)
+		if err != nil {
+			return graphql.NewGraphqlTemplatesCreateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return graphql.NewGraphqlTemplatesCreateCreated().WithPayload(payload)
+	})
+
+	api.GraphqlGraphqlTemplatesUpdateHandler = graphql.GraphqlTemplatesUpdateHandlerFunc(func(params graphql.GraphqlTemplatesUpdateParams, principal *models.Principal) middleware.Responder {
+		if params.Body.Name != "" && params.Body.Name != params.Name {
+			return graphql.NewGraphqlTemplatesUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf(
+					"name %q in the body doesn't match the name %q of the template", params.Body.Name, params.Name)))
+		}
+		if params.Body.Version < 0 {
+			return graphql.NewGraphqlTemplatesUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf("version must not be negative")))
+		}
+		template, err := templateFromModel(params.Name, params.Body)
+		if err != nil {
+			return graphql.NewGraphqlTemplatesUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+
+		updated, err := templates.Update(params.HTTPRequest.Context(), principal, template, uint64(params.Body.Version))
+		if err != nil {
+			switch {
+			case errors.As(err, &authzerrors.Forbidden{}):
+				return graphql.NewGraphqlTemplatesUpdateForbidden().
+					WithPayload(errPayloadFromSingleErr(err))
+			case errors.Is(err, querytemplates.ErrInvalid):
+				return graphql.NewGraphqlTemplatesUpdateUnprocessableEntity().
+					WithPayload(errPayloadFromSingleErr(err))
+			case errors.Is(err, querytemplates.ErrNotFound):
+				return graphql.NewGraphqlTemplatesUpdateNotFound().
+					WithPayload(errPayloadFromSingleErr(err))
+			case errors.Is(err, querytemplates.ErrVersion):
+				return graphql.NewGraphqlTemplatesUpdateConflict().
+					WithPayload(errPayloadFromSingleErr(err))
+			default:
+				return graphql.NewGraphqlTemplatesUpdateInternalServerError().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+		}
+
+		payload, err := templateToModel(updated)
+		if err != nil {
+			return graphql.NewGraphqlTemplatesUpdateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return graphql.NewGraphqlTemplatesUpdateOK().WithPayload(payload)
+	})
+
+	api.GraphqlGraphqlTemplatesDeleteHandler = graphql.GraphqlTemplatesDeleteHandlerFunc(func(params graphql.GraphqlTemplatesDeleteParams, principal *models.Principal) middleware.Responder {
+		if err := templates.Delete(params.HTTPRequest.Context(), principal, params.Name); err != nil {
+			switch {
+			case errors.As(err, &authzerrors.Forbidden{}):
+				return graphql.NewGraphqlTemplatesDeleteForbidden().
+					WithPayload(errPayloadFromSingleErr(err))
+			case errors.Is(err, querytemplates.ErrNotFound):
+				return graphql.NewGraphqlTemplatesDeleteNotFound().
+					WithPayload(errPayloadFromSingleErr(err))
+			default:
+				return graphql.NewGraphqlTemplatesDeleteInternalServerError().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+		}
+		return graphql.NewGraphqlTemplatesDeleteNoContent()
+	})
+
+	api.GraphqlGraphqlTemplatesExecuteHandler = graphql.GraphqlTemplatesExecuteHandlerFunc(func(params graphql.GraphqlTemplatesExecuteParams, principal *models.Principal) middleware.Responder {
+		// same as for regular requests, resolving the query needs permissions
+		// to read the schema
+		err := m.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata()...)
+		if err != nil {
+			metricRequestsTotal.logUserError()
+			switch {
+			case errors.As(err, &authzerrors.Forbidden{}):
+				return graphql.NewGraphqlTemplatesExecuteForbidden().
+					WithPayload(errPayloadFromSingleErr(
+						fmt.Errorf("due to GraphQL introspection, this role must have the permission to `read_collections` on `*` (all) collections: %w", err),
+					))
+			default:
+				return graphql.NewGraphqlTemplatesExecuteUnprocessableEntity().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+		}
+
+		if disabled {
+			metricRequestsTotal.logUserError()
+			return graphql.NewGraphqlTemplatesExecuteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf("graphql api is disabled")))
+		}
+
+		if params.Body.Version < 0 {
+			metricRequestsTotal.logUserError()
+			return graphql.NewGraphqlTemplatesExecuteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf("version must not be negative")))
+		}
+
+		template, err := templates.Get(principal, params.Name, uint64(params.Body.Version))
+		if err != nil {
+			metricRequestsTotal.logUserError()
+			switch {
+			case errors.Is(err, querytemplates.ErrNotFound):
+				return graphql.NewGraphqlTemplatesExecuteNotFound().
+					WithPayload(errPayloadFromSingleErr(err))
+			case errors.Is(err, querytemplates.ErrForbidden):
+				return graphql.NewGraphqlTemplatesExecuteForbidden().
+					WithPayload(errPayloadFromSingleErr(err))
+			case errors.Is(err, querytemplates.ErrVersion):
+				return graphql.NewGraphqlTemplatesExecuteUnprocessableEntity().
+					WithPayload(errPayloadFromSingleErr(err))
+			default:
+				return graphql.NewGraphqlTemplatesExecuteInternalServerError().
+					WithPayload(errPayloadFromSingleErr(err))
+			}
+		}
+		if template.Query == "" {
+			metricRequestsTotal.logUserError()
+			return graphql.NewGraphqlTemplatesExecuteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf(
+					"query template %q holds a search request, execute it through the ExecuteTemplate gRPC method",
+					template.Name)))
+		}
+
+		var variables map[string]interface{}
+		if params.Body.Variables != nil {
+			var ok bool
+			variables, ok = params.Body.Variables.(map[string]interface{})
+			if !ok {
+				metricRequestsTotal.logUserError()
+				return graphql.NewGraphqlTemplatesExecuteUnprocessableEntity().
+					WithPayload(errPayloadFromSingleErr(fmt.Errorf(
+						"expected variables to be an object, received %v", params.Body.Variables)))
+			}
+		}
+
+		graphQL := gqlProvider.GetGraphQL()
+		if graphQL == nil {
+			metricRequestsTotal.logUserError()
+			return graphql.NewGraphqlTemplatesExecuteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf("no graphql provider present, " +
+					"this is most likely because no schema is present. Import a schema first!")))
+		}
+
+		ctx := restCtx.AddPrincipalToContext(params.HTTPRequest.Context(), principal)
+		result := graphQL.Resolve(ctx, template.Query, "", variables)
+
+		resultJSON, err := json.Marshal(result)
+		if err != nil {
+			metricRequestsTotal.logUserError()
+			return graphql.NewGraphqlTemplatesExecuteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf("couldn't marshal json: %w", err)))
+		}
+		graphQLResponse := &models.GraphQLResponse{}
+		if err := json.Unmarshal(resultJSON, graphQLResponse); err != nil {
+			metricRequestsTotal.logUserError()
+			return graphql.NewGraphqlTemplatesExecuteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(fmt.Errorf("couldn't unmarshal json: %w", err)))
+		}
+
+		metricRequestsTotal.log(result)
+		if payload, ok := rateLimitedPayload(result); ok {
+			return graphql.NewGraphqlTemplatesExecuteTooManyRequests().WithPayload(payload)
+		}
+		return graphql.NewGraphqlTemplatesExecuteOK().WithPayload(graphQLResponse)
+	})
+}
+
+func templateToModel(t command.QueryTemplate) (*models.GraphQLTemplate, error) {
+	template := &models.GraphQLTemplate{
+		Name:        t.Name,
+		Version:     int64(t.Version),
+		Description: t.Description,
+		Query:       t.Query,
+		Roles:       t.Roles,
+	}
+	if len(t.Search) > 0 {
+		dec := json.NewDecoder(bytes.NewReader(t.Search))
+		// int64 values of the search request must not lose precision
+		dec.UseNumber()
+		if err := dec.Decode(&template.Search); err != nil {
+			return nil, fmt.Errorf("decode search of query template %q: %w", t.Name, err)
+		}
+	}
+	return template, nil
+}
+
+func templateFromModel(name string, m *models.GraphQLTemplate) (command.QueryTemplate, error) {
+	template := command.QueryTemplate{
+		Name:        name,
+		Description: m.Description,
+		Query:       m.Query,
+		Roles:       m.Roles,
+	}
+	if m.Search != nil {
+		search, err := json.Marshal(m.Search)
+		if err != nil {
+			return command.QueryTemplate{}, fmt.Errorf("encode search: %w", err)
+		}
+		template.Search = search
+	}
+	return template, nil
+}
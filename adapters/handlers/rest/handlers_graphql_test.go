@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tailorincgraphql "github.com/tailor-inc/graphql"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/qos"
+)
+
+func TestRateLimitedPayload(t *testing.T) {
+	field := func(err error) *tailorincgraphql.Field {
+		return &tailorincgraphql.Field{
+			Type: tailorincgraphql.String,
+			Resolve: func(p tailorincgraphql.ResolveParams) (interface{}, error) {
+				if err != nil {
+					return nil, err
+				}
+				return "resolved", nil
+			},
+		}
+	}
+	queued := func() error {
+		return enterrors.NewErrGraphQLUser(
+			enterrors.NewErrRateLimitf("%w: qos class %q", qos.ErrQueueFull, "batch"), "Get", "Article")
+	}
+	schema, err := tailorincgraphql.NewSchema(tailorincgraphql.SchemaConfig{
+		Query: tailorincgraphql.NewObject(tailorincgraphql.ObjectConfig{
+			Name: "Query",
+			Fields: tailorincgraphql.Fields{
+				"admitted": field(nil),
+				"failed":   field(enterrors.NewErrGraphQLUser(errors.New("boom"), "Get", "Article")),
+				"queued":   field(queued()),
+				"limited": field(enterrors.NewErrGraphQLUser(
+					enterrors.NewErrRateLimit(), "Get", "Article")),
+				"Get": &tailorincgraphql.Field{
+					Type: tailorincgraphql.NewObject(tailorincgraphql.ObjectConfig{
+						Name: "GetObj",
+						Fields: tailorincgraphql.Fields{
+							"Article": field(queued()),
+							"Video":   field(nil),
+						},
+					}),
+					Resolve: func(p tailorincgraphql.ResolveParams) (interface{}, error) {
+						return map[string]interface{}{}, nil
+					},
+				},
+			},
+		}),
+	})
+	require.Nil(t, err)
+	resolve := func(query string) *tailorincgraphql.Result {
+		return tailorincgraphql.Do(tailorincgraphql.Params{Schema: schema, RequestString: query})
+	}
+
+	t.Run("no errors", func(t *testing.T) {
+		_, ok := rateLimitedPayload(resolve("{ admitted }"))
+		assert.False(t, ok)
+	})
+
+	t.Run("other errors", func(t *testing.T) {
+		_, ok := rateLimitedPayload(resolve("{ failed }"))
+		assert.False(t, ok)
+
+		_, ok = rateLimitedPayload(resolve("{ failed queued }"))
+		assert.False(t, ok)
+	})
+
+	t.Run("limit of concurrent Get queries", func(t *testing.T) {
+		_, ok := rateLimitedPayload(resolve("{ limited }"))
+		assert.False(t, ok)
+	})
+
+	t.Run("partially resolved", func(t *testing.T) {
+		_, ok := rateLimitedPayload(resolve("{ admitted queued }"))
+		assert.False(t, ok)
+
+		_, ok = rateLimitedPayload(resolve("{ Get { Article Video } }"))
+		assert.False(t, ok)
+	})
+
+	t.Run("nothing admitted", func(t *testing.T) {
+		payload, ok := rateLimitedPayload(resolve("{ queued }"))
+		require.True(t, ok)
+		require.Len(t, payload.Error, 1)
+		assert.Equal(t, `429 Too many requests: query queue is full: qos class "batch"`, payload.Error[0].Message)
+
+		payload, ok = rateLimitedPayload(resolve("{ Get { Article } }"))
+		require.True(t, ok)
+		require.Len(t, payload.Error, 1)
+	})
+}
@@ -54,7 +54,7 @@ type objectsManager interface {
 	ValidateObject(context.Context, *models.Principal,
 		*models.Object, *additional.ReplicationProperties) error
 	GetObject(context.Context, *models.Principal, string, strfmt.UUID,
-		additional.Properties, *additional.ReplicationProperties, string) (*models.Object, error)
+		additional.Properties, *uco.Projection, *additional.ReplicationProperties, string) (*models.Object, error)
 	DeleteObject(context.Context, *models.Principal, string,
 		strfmt.UUID, *additional.ReplicationProperties, string) error
 	UpdateObject(context.Context, *models.Principal, string, strfmt.UUID,
@@ -62,7 +62,7 @@ type objectsManager interface {
 	HeadObject(ctx context.Context, principal *models.Principal, class string, id strfmt.UUID,
 		repl *additional.ReplicationProperties, tenant string) (bool, *uco.Error)
 	GetObjects(context.Context, *models.Principal, *int64, *int64,
-		*string, *string, *string, additional.Properties, string) ([]*models.Object, error)
+		*string, *string, *string, additional.Properties, *uco.Projection, string) ([]*models.Object, error)
 	Query(ctx context.Context, principal *models.Principal,
 		params *uco.QueryParams) ([]*models.Object, *uco.Error)
 	MergeObject(context.Context, *models.Principal, *models.Object,
@@ -98,6 +98,9 @@ func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 		} else if errors.As(err, &uco.ErrMultiTenancy{}) {
 			return objects.NewObjectsCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &uco.ErrReadOnly{}) {
+			return objects.NewObjectsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
 		} else if errors.As(err, &authzerrors.Forbidden{}) {
 			return objects.NewObjectsCreateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -179,6 +182,13 @@ func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 		}
 	}
 
+	projection, err := parseProjectionParams(params.Properties, params.ExcludeProperties)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		return objects.NewObjectsClassGetBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
 	replProps, err := getReplicationProperties(params.ConsistencyLevel, params.NodeName)
 	if err != nil {
 		h.metricRequestsTotal.logError(params.ClassName, err)
@@ -189,7 +199,7 @@ func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 	tenant := getTenant(params.Tenant)
 
 	object, err := h.manager.GetObject(ctx, principal,
-		params.ClassName, params.ID, additional, replProps, tenant)
+		params.ClassName, params.ID, additional, projection, replProps, tenant)
 	if err != nil {
 		h.metricRequestsTotal.logError(getClassName(object), err)
 		switch {
@@ -198,6 +208,9 @@ func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 				WithPayload(errPayloadFromSingleErr(err))
 		case errors.As(err, &uco.ErrNotFound{}):
 			return objects.NewObjectsClassGetNotFound()
+		case errors.As(err, &uco.ErrInvalidUserInput{}):
+			return objects.NewObjectsClassGetBadRequest().
+				WithPayload(errPayloadFromSingleErr(err))
 		case errors.As(err, &uco.ErrMultiTenancy{}):
 			return objects.NewObjectsClassGetUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -229,12 +242,18 @@ func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
 		return objects.NewObjectsListBadRequest().
 			WithPayload(errPayloadFromSingleErr(err))
 	}
+	projection, err := parseProjectionParams(params.Properties, params.ExcludeProperties)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		return objects.NewObjectsListBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
 
 	var deprecationsRes []*models.Deprecation
 
 	list, err := h.manager.GetObjects(ctx, principal,
 		params.Offset, params.Limit, params.Sort, params.Order, params.After, additional,
-		getTenant(params.Tenant))
+		projection, getTenant(params.Tenant))
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
 		switch {
@@ -276,6 +295,12 @@ func (h *objectHandlers) query(params objects.ObjectsListParams,
 		return objects.NewObjectsListBadRequest().
 			WithPayload(errPayloadFromSingleErr(err))
 	}
+	projection, err := parseProjectionParams(params.Properties, params.ExcludeProperties)
+	if err != nil {
+		h.metricRequestsTotal.logError(*params.Class, err)
+		return objects.NewObjectsListBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
 	req := uco.QueryParams{
 		Class:      *params.Class,
 		Offset:     params.Offset,
@@ -285,6 +310,7 @@ func (h *objectHandlers) query(params objects.ObjectsListParams,
 		Order:      params.Order,
 		Tenant:     params.Tenant,
 		Additional: additional,
+		Projection: projection,
 	}
 	resultSet, rerr := h.manager.Query(ctx, principal, &req)
 	if rerr != nil {
@@ -350,6 +376,9 @@ func (h *objectHandlers) deleteObject(params objects.ObjectsClassDeleteParams,
 		case errors.As(err, &uco.ErrMultiTenancy{}):
 			return objects.NewObjectsClassDeleteUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &uco.ErrReadOnly{}):
+			return objects.NewObjectsClassDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
 		default:
 			return objects.NewObjectsClassDeleteInternalServerError().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -382,6 +411,9 @@ func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 		} else if errors.As(err, &uco.ErrMultiTenancy{}) {
 			return objects.NewObjectsClassPutUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &uco.ErrReadOnly{}) {
+			return objects.NewObjectsClassPutUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
 		} else if errors.As(err, &authzerrors.Forbidden{}) {
 			return objects.NewObjectsClassPutForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -663,9 +695,11 @@ func (h *objectHandlers) getObjectDeprecated(params objects.ObjectsGetParams,
 ) middleware.Responder {
 	h.logger.Warn("deprecated endpoint: ", "GET "+params.HTTPRequest.URL.Path)
 	ps := objects.ObjectsClassGetParams{
-		HTTPRequest: params.HTTPRequest,
-		ID:          params.ID,
-		Include:     params.Include,
+		HTTPRequest:       params.HTTPRequest,
+		ID:                params.ID,
+		Include:           params.Include,
+		Properties:        params.Properties,
+		ExcludeProperties: params.ExcludeProperties,
 	}
 	return h.getObject(ps, principal)
 }
@@ -878,6 +912,25 @@ func parseIncludeParam(in *string, modulesProvider ModulesProvider, includeModul
 	return out, nil
 }
 
+// parseProjectionParams parses the comma separated lists of properties to
+// return or to leave out of the response
+func parseProjectionParams(properties, excludeProperties *string) (*uco.Projection, error) {
+	split := func(in *string) []string {
+		if in == nil {
+			return nil
+		}
+		var out []string
+		for _, prop := range strings.Split(*in, ",") {
+			if prop = strings.TrimSpace(prop); prop != "" {
+				out = append(out, prop)
+			}
+		}
+		return out
+	}
+
+	return uco.NewProjection(split(properties), split(excludeProperties))
+}
+
 func getModuleParams(moduleParams map[string]interface{}) map[string]interface{} {
 	if moduleParams == nil {
 		return map[string]interface{}{}
@@ -20,7 +20,9 @@ import (
 
 	restCtx "github.com/weaviate/weaviate/adapters/handlers/rest/context"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/models"
 	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -357,6 +359,60 @@ func (s *schemaHandlers) tenantExists(params schema.TenantExistsParams, principa
 	return schema.NewTenantExistsOK()
 }
 
+func (s *schemaHandlers) getWriteModes(params cluster.ClusterGetWriteModesParams,
+	principal *models.Principal,
+) middleware.Responder {
+	modes, err := s.manager.GetWriteModes(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &authzerrors.Forbidden{}):
+			return cluster.NewClusterGetWriteModesForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return cluster.NewClusterGetWriteModesInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return cluster.NewClusterGetWriteModesOK().WithPayload(writeModesToModel(modes))
+}
+
+func (s *schemaHandlers) updateWriteMode(params cluster.ClusterUpdateWriteModeParams,
+	principal *models.Principal,
+) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	err := s.manager.UpdateWriteMode(ctx, principal, params.Body.Collection,
+		command.WriteMode(params.Body.Mode))
+	if err == nil {
+		var modes schemaUC.WriteModes
+		modes, err = s.manager.GetWriteModes(ctx, principal)
+		if err == nil {
+			s.metricRequestsTotal.logOk(params.Body.Collection)
+			return cluster.NewClusterUpdateWriteModeOK().WithPayload(writeModesToModel(modes))
+		}
+	}
+
+	s.metricRequestsTotal.logError(params.Body.Collection, err)
+	switch {
+	case errors.As(err, &authzerrors.Forbidden{}):
+		return cluster.NewClusterUpdateWriteModeForbidden().
+			WithPayload(errPayloadFromSingleErr(err))
+	default:
+		return cluster.NewClusterUpdateWriteModeUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+}
+
+func writeModesToModel(modes schemaUC.WriteModes) *models.WriteModes {
+	collections := make(map[string]string, len(modes.Collections))
+	for collection, mode := range modes.Collections {
+		collections[collection] = string(mode)
+	}
+	return &models.WriteModes{Cluster: string(modes.Cluster), Collections: collections}
+}
+
 func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) {
 	h := &schemaHandlers{manager, newSchemaRequestsTotal(metrics, logger)}
 
@@ -386,6 +442,11 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 	api.SchemaTenantsGetHandler = schema.TenantsGetHandlerFunc(h.getTenants)
 	api.SchemaTenantExistsHandler = schema.TenantExistsHandlerFunc(h.tenantExists)
 	api.SchemaTenantsGetOneHandler = schema.TenantsGetOneHandlerFunc(h.getTenant)
+
+	api.ClusterClusterGetWriteModesHandler = cluster.
+		ClusterGetWriteModesHandlerFunc(h.getWriteModes)
+	api.ClusterClusterUpdateWriteModeHandler = cluster.
+		ClusterUpdateWriteModeHandlerFunc(h.updateWriteMode)
 }
 
 type schemaRequestsTotal struct {
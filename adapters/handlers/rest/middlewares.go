@@ -12,8 +12,10 @@
 package rest
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"regexp"
 	"strings"
@@ -126,6 +128,7 @@ func makeSetupGlobalMiddleware(appState *state.State, context *middleware.Contex
 		handler = addPreflight(handler, appState.ServerConfig.Config.CORS)
 		handler = addLiveAndReadyness(appState, handler)
 		handler = addHandleRoot(handler)
+		handler = addGraphQLBatchRouting(handler)
 		handler = makeAddModuleHandlers(appState.Modules)(handler)
 		handler = addInjectHeadersIntoContext(handler)
 		handler = makeCatchPanics(appState.Logger, newPanicsRequestsTotal(appState.Metrics, appState.Logger))(handler)
@@ -210,6 +213,47 @@ func makeAddMonitoring(metrics *monitoring.PrometheusMetrics) func(http.Handler)
 	}
 }
 
+// addGraphQLBatchRouting routes POST /v1/graphql requests whose body is a
+// JSON array to the batch endpoint, so that clients can send several
+// operations in a single request without having to know about
+// /v1/graphql/batch
+func addGraphQLBatchRouting(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/graphql" || r.Body == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body := bufio.NewReader(r.Body)
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{body, r.Body}
+
+		if isJSONArray(body) {
+			r.URL.Path = "/v1/graphql/batch"
+			r.URL.RawPath = ""
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isJSONArray peeks at the first non-whitespace byte without consuming it
+func isJSONArray(body *bufio.Reader) bool {
+	for i := 1; ; i++ {
+		b, err := body.Peek(i)
+		if len(b) < i || err != nil {
+			return false
+		}
+		switch b[i-1] {
+		case ' ', '\t', '\r', '\n':
+			continue
+		default:
+			return b[i-1] == '['
+		}
+	}
+}
+
 func addPreflight(next http.Handler, cfg config.CORS) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", cfg.AllowOrigin)
@@ -12,7 +12,10 @@
 package rest
 
 import (
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/go-openapi/loads"
@@ -67,6 +70,63 @@ func Test_staticRoute(t *testing.T) {
 	}
 }
 
+func Test_addGraphQLBatchRouting(t *testing.T) {
+	cases := []struct {
+		name         string
+		method       string
+		path         string
+		body         string
+		expectedPath string
+	}{
+		{
+			name:         "single operation",
+			method:       http.MethodPost,
+			path:         "/v1/graphql",
+			body:         `{"query":"{ Get { Foo { name } } }"}`,
+			expectedPath: "/v1/graphql",
+		},
+		{
+			name:         "array of operations",
+			method:       http.MethodPost,
+			path:         "/v1/graphql",
+			body:         "\n  [{\"query\":\"{ Get { Foo { name } } }\"}]",
+			expectedPath: "/v1/graphql/batch",
+		},
+		{
+			name:         "empty body",
+			method:       http.MethodPost,
+			path:         "/v1/graphql",
+			expectedPath: "/v1/graphql",
+		},
+		{
+			name:         "other path",
+			method:       http.MethodPost,
+			path:         "/v1/objects",
+			body:         `[]`,
+			expectedPath: "/v1/objects",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotPath, gotBody string
+			handler := addGraphQLBatchRouting(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.Path
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				gotBody = string(body)
+			}))
+
+			req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, tc.expectedPath, gotPath)
+			// the body must still be readable in full by the handler
+			assert.Equal(t, tc.body, gotBody)
+		})
+	}
+}
+
 func newRequest(t *testing.T, path string) *http.Request {
 	t.Helper()
 
@@ -184,6 +184,51 @@ func (o *GraphqlPostUnprocessableEntity) WriteResponse(rw http.ResponseWriter, p
 	}
 }
 
+// GraphqlPostTooManyRequestsCode is the HTTP code returned for type GraphqlPostTooManyRequests
+const GraphqlPostTooManyRequestsCode int = 429
+
+/*
+GraphqlPostTooManyRequests None of the queries were admitted by their QoS class, because too many queries are running or queued. Retry it later.
+
+swagger:response graphqlPostTooManyRequests
+*/
+type GraphqlPostTooManyRequests struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGraphqlPostTooManyRequests creates GraphqlPostTooManyRequests with default headers values
+func NewGraphqlPostTooManyRequests() *GraphqlPostTooManyRequests {
+
+	return &GraphqlPostTooManyRequests{}
+}
+
+// WithPayload adds the payload to the graphql post too many requests response
+func (o *GraphqlPostTooManyRequests) WithPayload(payload *models.ErrorResponse) *GraphqlPostTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the graphql post too many requests response
+func (o *GraphqlPostTooManyRequests) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GraphqlPostTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // GraphqlPostInternalServerErrorCode is the HTTP code returned for type GraphqlPostInternalServerError
 const GraphqlPostInternalServerErrorCode int = 500
 
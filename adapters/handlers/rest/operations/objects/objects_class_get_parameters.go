@@ -52,6 +52,10 @@ type ObjectsClassGetParams struct {
 	  In: query
 	*/
 	ConsistencyLevel *string
+	/*Comma-separated list of the properties to leave out of the response, e.g. `body,summary`. <br/><br/>Cannot be used with `properties`.
+	  In: query
+	*/
+	ExcludeProperties *string
 	/*Unique ID of the Object.
 	  Required: true
 	  In: path
@@ -65,6 +69,10 @@ type ObjectsClassGetParams struct {
 	  In: query
 	*/
 	NodeName *string
+	/*Comma-separated list of the properties to return, e.g. `title,author`. All other properties are left out of the response. <br/><br/>Cannot be used with `exclude_properties`.
+	  In: query
+	*/
+	Properties *string
 	/*Specifies the tenant in a request targeting a multi-tenant class
 	  In: query
 	*/
@@ -92,6 +100,11 @@ func (o *ObjectsClassGetParams) BindRequest(r *http.Request, route *middleware.M
 		res = append(res, err)
 	}
 
+	qExcludeProperties, qhkExcludeProperties, _ := qs.GetOK("exclude_properties")
+	if err := o.bindExcludeProperties(qExcludeProperties, qhkExcludeProperties, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rID, rhkID, _ := route.Params.GetOK("id")
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
@@ -107,6 +120,11 @@ func (o *ObjectsClassGetParams) BindRequest(r *http.Request, route *middleware.M
 		res = append(res, err)
 	}
 
+	qProperties, qhkProperties, _ := qs.GetOK("properties")
+	if err := o.bindProperties(qProperties, qhkProperties, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qTenant, qhkTenant, _ := qs.GetOK("tenant")
 	if err := o.bindTenant(qTenant, qhkTenant, route.Formats); err != nil {
 		res = append(res, err)
@@ -149,6 +167,24 @@ func (o *ObjectsClassGetParams) bindConsistencyLevel(rawData []string, hasKey bo
 	return nil
 }
 
+// bindExcludeProperties binds and validates parameter ExcludeProperties from query.
+func (o *ObjectsClassGetParams) bindExcludeProperties(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.ExcludeProperties = &raw
+
+	return nil
+}
+
 // bindID binds and validates parameter ID from path.
 func (o *ObjectsClassGetParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -218,6 +254,24 @@ func (o *ObjectsClassGetParams) bindNodeName(rawData []string, hasKey bool, form
 	return nil
 }
 
+// bindProperties binds and validates parameter Properties from query.
+func (o *ObjectsClassGetParams) bindProperties(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Properties = &raw
+
+	return nil
+}
+
 // bindTenant binds and validates parameter Tenant from query.
 func (o *ObjectsClassGetParams) bindTenant(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
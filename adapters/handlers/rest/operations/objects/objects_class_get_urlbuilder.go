@@ -30,10 +30,12 @@ type ObjectsClassGetURL struct {
 	ClassName string
 	ID        strfmt.UUID
 
-	ConsistencyLevel *string
-	Include          *string
-	NodeName         *string
-	Tenant           *string
+	ConsistencyLevel  *string
+	ExcludeProperties *string
+	Include           *string
+	NodeName          *string
+	Properties        *string
+	Tenant            *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -91,6 +93,14 @@ func (o *ObjectsClassGetURL) Build() (*url.URL, error) {
 		qs.Set("consistency_level", consistencyLevelQ)
 	}
 
+	var excludePropertiesQ string
+	if o.ExcludeProperties != nil {
+		excludePropertiesQ = *o.ExcludeProperties
+	}
+	if excludePropertiesQ != "" {
+		qs.Set("exclude_properties", excludePropertiesQ)
+	}
+
 	var includeQ string
 	if o.Include != nil {
 		includeQ = *o.Include
@@ -107,6 +117,14 @@ func (o *ObjectsClassGetURL) Build() (*url.URL, error) {
 		qs.Set("node_name", nodeNameQ)
 	}
 
+	var propertiesQ string
+	if o.Properties != nil {
+		propertiesQ = *o.Properties
+	}
+	if propertiesQ != "" {
+		qs.Set("properties", propertiesQ)
+	}
+
 	var tenantQ string
 	if o.Tenant != nil {
 		tenantQ = *o.Tenant
@@ -43,6 +43,10 @@ type ObjectsGetParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Comma-separated list of the properties to leave out of the response, e.g. `body,summary`. <br/><br/>Cannot be used with `properties`.
+	  In: query
+	*/
+	ExcludeProperties *string
 	/*Unique ID of the Object.
 	  Required: true
 	  In: path
@@ -52,6 +56,10 @@ type ObjectsGetParams struct {
 	  In: query
 	*/
 	Include *string
+	/*Comma-separated list of the properties to return, e.g. `title,author`. All other properties are left out of the response. <br/><br/>Cannot be used with `exclude_properties`.
+	  In: query
+	*/
+	Properties *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -65,6 +73,11 @@ func (o *ObjectsGetParams) BindRequest(r *http.Request, route *middleware.Matche
 
 	qs := runtime.Values(r.URL.Query())
 
+	qExcludeProperties, qhkExcludeProperties, _ := qs.GetOK("exclude_properties")
+	if err := o.bindExcludeProperties(qExcludeProperties, qhkExcludeProperties, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rID, rhkID, _ := route.Params.GetOK("id")
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
@@ -74,12 +87,35 @@ func (o *ObjectsGetParams) BindRequest(r *http.Request, route *middleware.Matche
 	if err := o.bindInclude(qInclude, qhkInclude, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qProperties, qhkProperties, _ := qs.GetOK("properties")
+	if err := o.bindProperties(qProperties, qhkProperties, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindExcludeProperties binds and validates parameter ExcludeProperties from query.
+func (o *ObjectsGetParams) bindExcludeProperties(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.ExcludeProperties = &raw
+
+	return nil
+}
+
 // bindID binds and validates parameter ID from path.
 func (o *ObjectsGetParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -130,3 +166,21 @@ func (o *ObjectsGetParams) bindInclude(rawData []string, hasKey bool, formats st
 
 	return nil
 }
+
+// bindProperties binds and validates parameter Properties from query.
+func (o *ObjectsGetParams) bindProperties(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Properties = &raw
+
+	return nil
+}
@@ -29,7 +29,9 @@ import (
 type ObjectsGetURL struct {
 	ID strfmt.UUID
 
-	Include *string
+	ExcludeProperties *string
+	Include           *string
+	Properties        *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -72,6 +74,14 @@ func (o *ObjectsGetURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var excludePropertiesQ string
+	if o.ExcludeProperties != nil {
+		excludePropertiesQ = *o.ExcludeProperties
+	}
+	if excludePropertiesQ != "" {
+		qs.Set("exclude_properties", excludePropertiesQ)
+	}
+
 	var includeQ string
 	if o.Include != nil {
 		includeQ = *o.Include
@@ -80,6 +90,14 @@ func (o *ObjectsGetURL) Build() (*url.URL, error) {
 		qs.Set("include", includeQ)
 	}
 
+	var propertiesQ string
+	if o.Properties != nil {
+		propertiesQ = *o.Properties
+	}
+	if propertiesQ != "" {
+		qs.Set("properties", propertiesQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
@@ -58,6 +58,10 @@ type ObjectsListParams struct {
 	  In: query
 	*/
 	Class *string
+	/*Comma-separated list of the properties to leave out of the response, e.g. `body,summary`. <br/><br/>Cannot be used with `properties`.
+	  In: query
+	*/
+	ExcludeProperties *string
 	/*Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation
 	  In: query
 	*/
@@ -75,6 +79,10 @@ type ObjectsListParams struct {
 	  In: query
 	*/
 	Order *string
+	/*Comma-separated list of the properties to return, e.g. `title,author`. All other properties are left out of the response. <br/><br/>Cannot be used with `exclude_properties`.
+	  In: query
+	*/
+	Properties *string
 	/*Name(s) of the property to sort by - e.g. `city`, or `country,city`.
 	  In: query
 	*/
@@ -106,6 +114,11 @@ func (o *ObjectsListParams) BindRequest(r *http.Request, route *middleware.Match
 		res = append(res, err)
 	}
 
+	qExcludeProperties, qhkExcludeProperties, _ := qs.GetOK("exclude_properties")
+	if err := o.bindExcludeProperties(qExcludeProperties, qhkExcludeProperties, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qInclude, qhkInclude, _ := qs.GetOK("include")
 	if err := o.bindInclude(qInclude, qhkInclude, route.Formats); err != nil {
 		res = append(res, err)
@@ -126,6 +139,11 @@ func (o *ObjectsListParams) BindRequest(r *http.Request, route *middleware.Match
 		res = append(res, err)
 	}
 
+	qProperties, qhkProperties, _ := qs.GetOK("properties")
+	if err := o.bindProperties(qProperties, qhkProperties, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qSort, qhkSort, _ := qs.GetOK("sort")
 	if err := o.bindSort(qSort, qhkSort, route.Formats); err != nil {
 		res = append(res, err)
@@ -177,6 +195,24 @@ func (o *ObjectsListParams) bindClass(rawData []string, hasKey bool, formats str
 	return nil
 }
 
+// bindExcludeProperties binds and validates parameter ExcludeProperties from query.
+func (o *ObjectsListParams) bindExcludeProperties(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.ExcludeProperties = &raw
+
+	return nil
+}
+
 // bindInclude binds and validates parameter Include from query.
 func (o *ObjectsListParams) bindInclude(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -260,6 +296,24 @@ func (o *ObjectsListParams) bindOrder(rawData []string, hasKey bool, formats str
 	return nil
 }
 
+// bindProperties binds and validates parameter Properties from query.
+func (o *ObjectsListParams) bindProperties(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Properties = &raw
+
+	return nil
+}
+
 // bindSort binds and validates parameter Sort from query.
 func (o *ObjectsListParams) bindSort(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
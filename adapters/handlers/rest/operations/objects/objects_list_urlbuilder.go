@@ -26,14 +26,16 @@ import (
 
 // ObjectsListURL generates an URL for the objects list operation
 type ObjectsListURL struct {
-	After   *string
-	Class   *string
-	Include *string
-	Limit   *int64
-	Offset  *int64
-	Order   *string
-	Sort    *string
-	Tenant  *string
+	After             *string
+	Class             *string
+	ExcludeProperties *string
+	Include           *string
+	Limit             *int64
+	Offset            *int64
+	Order             *string
+	Properties        *string
+	Sort              *string
+	Tenant            *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -85,6 +87,14 @@ func (o *ObjectsListURL) Build() (*url.URL, error) {
 		qs.Set("class", classQ)
 	}
 
+	var excludePropertiesQ string
+	if o.ExcludeProperties != nil {
+		excludePropertiesQ = *o.ExcludeProperties
+	}
+	if excludePropertiesQ != "" {
+		qs.Set("exclude_properties", excludePropertiesQ)
+	}
+
 	var includeQ string
 	if o.Include != nil {
 		includeQ = *o.Include
@@ -117,6 +127,14 @@ func (o *ObjectsListURL) Build() (*url.URL, error) {
 		qs.Set("order", orderQ)
 	}
 
+	var propertiesQ string
+	if o.Properties != nil {
+		propertiesQ = *o.Properties
+	}
+	if propertiesQ != "" {
+		qs.Set("properties", propertiesQ)
+	}
+
 	var sortQ string
 	if o.Sort != nil {
 		sortQ = *o.Sort
@@ -34,6 +34,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/authz"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/backups"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/benchmark"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/classifications"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/graphql"
@@ -112,6 +113,15 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		BatchBatchReferencesCreateHandler: batch.BatchReferencesCreateHandlerFunc(func(params batch.BatchReferencesCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchReferencesCreate has not yet been implemented")
 		}),
+		BenchmarkBenchmarkDatasetsCreateHandler: benchmark.BenchmarkDatasetsCreateHandlerFunc(func(params benchmark.BenchmarkDatasetsCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation benchmark.BenchmarkDatasetsCreate has not yet been implemented")
+		}),
+		BenchmarkBenchmarkJobsGetHandler: benchmark.BenchmarkJobsGetHandlerFunc(func(params benchmark.BenchmarkJobsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation benchmark.BenchmarkJobsGet has not yet been implemented")
+		}),
+		BenchmarkBenchmarkRunsCreateHandler: benchmark.BenchmarkRunsCreateHandlerFunc(func(params benchmark.BenchmarkRunsCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation benchmark.BenchmarkRunsCreate has not yet been implemented")
+		}),
 		ClassificationsClassificationsGetHandler: classifications.ClassificationsGetHandlerFunc(func(params classifications.ClassificationsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation classifications.ClassificationsGet has not yet been implemented")
 		}),
@@ -121,6 +131,12 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ClusterClusterGetStatisticsHandler: cluster.ClusterGetStatisticsHandlerFunc(func(params cluster.ClusterGetStatisticsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation cluster.ClusterGetStatistics has not yet been implemented")
 		}),
+		ClusterClusterGetWriteModesHandler: cluster.ClusterGetWriteModesHandlerFunc(func(params cluster.ClusterGetWriteModesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterGetWriteModes has not yet been implemented")
+		}),
+		ClusterClusterUpdateWriteModeHandler: cluster.ClusterUpdateWriteModeHandlerFunc(func(params cluster.ClusterUpdateWriteModeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterUpdateWriteMode has not yet been implemented")
+		}),
 		AuthzCreateRoleHandler: authz.CreateRoleHandlerFunc(func(params authz.CreateRoleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation authz.CreateRole has not yet been implemented")
 		}),
@@ -166,6 +182,21 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		GraphqlGraphqlPostHandler: graphql.GraphqlPostHandlerFunc(func(params graphql.GraphqlPostParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation graphql.GraphqlPost has not yet been implemented")
 		}),
+		GraphqlGraphqlTemplatesCreateHandler: graphql.GraphqlTemplatesCreateHandlerFunc(func(params graphql.GraphqlTemplatesCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation graphql.GraphqlTemplatesCreate has not yet been implemented")
+		}),
+		GraphqlGraphqlTemplatesDeleteHandler: graphql.GraphqlTemplatesDeleteHandlerFunc(func(params graphql.GraphqlTemplatesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation graphql.GraphqlTemplatesDelete has not yet been implemented")
+		}),
+		GraphqlGraphqlTemplatesExecuteHandler: graphql.GraphqlTemplatesExecuteHandlerFunc(func(params graphql.GraphqlTemplatesExecuteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation graphql.GraphqlTemplatesExecute has not yet been implemented")
+		}),
+		GraphqlGraphqlTemplatesListHandler: graphql.GraphqlTemplatesListHandlerFunc(func(params graphql.GraphqlTemplatesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation graphql.GraphqlTemplatesList has not yet been implemented")
+		}),
+		GraphqlGraphqlTemplatesUpdateHandler: graphql.GraphqlTemplatesUpdateHandlerFunc(func(params graphql.GraphqlTemplatesUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation graphql.GraphqlTemplatesUpdate has not yet been implemented")
+		}),
 		AuthzHasPermissionHandler: authz.HasPermissionHandlerFunc(func(params authz.HasPermissionParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation authz.HasPermission has not yet been implemented")
 		}),
@@ -399,12 +430,22 @@ type WeaviateAPI struct {
 	BatchBatchObjectsDeleteHandler batch.BatchObjectsDeleteHandler
 	// BatchBatchReferencesCreateHandler sets the operation handler for the batch references create operation
 	BatchBatchReferencesCreateHandler batch.BatchReferencesCreateHandler
+	// BenchmarkBenchmarkDatasetsCreateHandler sets the operation handler for the benchmark datasets create operation
+	BenchmarkBenchmarkDatasetsCreateHandler benchmark.BenchmarkDatasetsCreateHandler
+	// BenchmarkBenchmarkJobsGetHandler sets the operation handler for the benchmark jobs get operation
+	BenchmarkBenchmarkJobsGetHandler benchmark.BenchmarkJobsGetHandler
+	// BenchmarkBenchmarkRunsCreateHandler sets the operation handler for the benchmark runs create operation
+	BenchmarkBenchmarkRunsCreateHandler benchmark.BenchmarkRunsCreateHandler
 	// ClassificationsClassificationsGetHandler sets the operation handler for the classifications get operation
 	ClassificationsClassificationsGetHandler classifications.ClassificationsGetHandler
 	// ClassificationsClassificationsPostHandler sets the operation handler for the classifications post operation
 	ClassificationsClassificationsPostHandler classifications.ClassificationsPostHandler
 	// ClusterClusterGetStatisticsHandler sets the operation handler for the cluster get statistics operation
 	ClusterClusterGetStatisticsHandler cluster.ClusterGetStatisticsHandler
+	// ClusterClusterGetWriteModesHandler sets the operation handler for the cluster get write modes operation
+	ClusterClusterGetWriteModesHandler cluster.ClusterGetWriteModesHandler
+	// ClusterClusterUpdateWriteModeHandler sets the operation handler for the cluster update write mode operation
+	ClusterClusterUpdateWriteModeHandler cluster.ClusterUpdateWriteModeHandler
 	// AuthzCreateRoleHandler sets the operation handler for the create role operation
 	AuthzCreateRoleHandler authz.CreateRoleHandler
 	// UsersCreateUserHandler sets the operation handler for the create user operation
@@ -435,6 +476,16 @@ type WeaviateAPI struct {
 	GraphqlGraphqlBatchHandler graphql.GraphqlBatchHandler
 	// GraphqlGraphqlPostHandler sets the operation handler for the graphql post operation
 	GraphqlGraphqlPostHandler graphql.GraphqlPostHandler
+	// GraphqlGraphqlTemplatesCreateHandler sets the operation handler for the graphql templates create operation
+	GraphqlGraphqlTemplatesCreateHandler graphql.GraphqlTemplatesCreateHandler
+	// GraphqlGraphqlTemplatesDeleteHandler sets the operation handler for the graphql templates delete operation
+	GraphqlGraphqlTemplatesDeleteHandler graphql.GraphqlTemplatesDeleteHandler
+	// GraphqlGraphqlTemplatesExecuteHandler sets the operation handler for the graphql templates execute operation
+	GraphqlGraphqlTemplatesExecuteHandler graphql.GraphqlTemplatesExecuteHandler
+	// GraphqlGraphqlTemplatesListHandler sets the operation handler for the graphql templates list operation
+	GraphqlGraphqlTemplatesListHandler graphql.GraphqlTemplatesListHandler
+	// GraphqlGraphqlTemplatesUpdateHandler sets the operation handler for the graphql templates update operation
+	GraphqlGraphqlTemplatesUpdateHandler graphql.GraphqlTemplatesUpdateHandler
 	// AuthzHasPermissionHandler sets the operation handler for the has permission operation
 	AuthzHasPermissionHandler authz.HasPermissionHandler
 	// UsersListAllUsersHandler sets the operation handler for the list all users operation
@@ -653,6 +704,15 @@ func (o *WeaviateAPI) Validate() error {
 	if o.BatchBatchReferencesCreateHandler == nil {
 		unregistered = append(unregistered, "batch.BatchReferencesCreateHandler")
 	}
+	if o.BenchmarkBenchmarkDatasetsCreateHandler == nil {
+		unregistered = append(unregistered, "benchmark.BenchmarkDatasetsCreateHandler")
+	}
+	if o.BenchmarkBenchmarkJobsGetHandler == nil {
+		unregistered = append(unregistered, "benchmark.BenchmarkJobsGetHandler")
+	}
+	if o.BenchmarkBenchmarkRunsCreateHandler == nil {
+		unregistered = append(unregistered, "benchmark.BenchmarkRunsCreateHandler")
+	}
 	if o.ClassificationsClassificationsGetHandler == nil {
 		unregistered = append(unregistered, "classifications.ClassificationsGetHandler")
 	}
@@ -662,6 +722,12 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ClusterClusterGetStatisticsHandler == nil {
 		unregistered = append(unregistered, "cluster.ClusterGetStatisticsHandler")
 	}
+	if o.ClusterClusterGetWriteModesHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterGetWriteModesHandler")
+	}
+	if o.ClusterClusterUpdateWriteModeHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterUpdateWriteModeHandler")
+	}
 	if o.AuthzCreateRoleHandler == nil {
 		unregistered = append(unregistered, "authz.CreateRoleHandler")
 	}
@@ -707,6 +773,21 @@ func (o *WeaviateAPI) Validate() error {
 	if o.GraphqlGraphqlPostHandler == nil {
 		unregistered = append(unregistered, "graphql.GraphqlPostHandler")
 	}
+	if o.GraphqlGraphqlTemplatesCreateHandler == nil {
+		unregistered = append(unregistered, "graphql.GraphqlTemplatesCreateHandler")
+	}
+	if o.GraphqlGraphqlTemplatesDeleteHandler == nil {
+		unregistered = append(unregistered, "graphql.GraphqlTemplatesDeleteHandler")
+	}
+	if o.GraphqlGraphqlTemplatesExecuteHandler == nil {
+		unregistered = append(unregistered, "graphql.GraphqlTemplatesExecuteHandler")
+	}
+	if o.GraphqlGraphqlTemplatesListHandler == nil {
+		unregistered = append(unregistered, "graphql.GraphqlTemplatesListHandler")
+	}
+	if o.GraphqlGraphqlTemplatesUpdateHandler == nil {
+		unregistered = append(unregistered, "graphql.GraphqlTemplatesUpdateHandler")
+	}
 	if o.AuthzHasPermissionHandler == nil {
 		unregistered = append(unregistered, "authz.HasPermissionHandler")
 	}
@@ -1001,6 +1082,18 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/batch/references"] = batch.NewBatchReferencesCreate(o.context, o.BatchBatchReferencesCreateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/benchmark/datasets"] = benchmark.NewBenchmarkDatasetsCreate(o.context, o.BenchmarkBenchmarkDatasetsCreateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/benchmark/jobs/{id}"] = benchmark.NewBenchmarkJobsGet(o.context, o.BenchmarkBenchmarkJobsGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/benchmark/runs"] = benchmark.NewBenchmarkRunsCreate(o.context, o.BenchmarkBenchmarkRunsCreateHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1013,6 +1106,14 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/cluster/statistics"] = cluster.NewClusterGetStatistics(o.context, o.ClusterClusterGetStatisticsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/cluster/write-modes"] = cluster.NewClusterGetWriteModes(o.context, o.ClusterClusterGetWriteModesHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/cluster/write-modes"] = cluster.NewClusterUpdateWriteMode(o.context, o.ClusterClusterUpdateWriteModeHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1076,6 +1177,26 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/graphql/templates"] = graphql.NewGraphqlTemplatesCreate(o.context, o.GraphqlGraphqlTemplatesCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/graphql/templates/{name}"] = graphql.NewGraphqlTemplatesDelete(o.context, o.GraphqlGraphqlTemplatesDeleteHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/graphql/templates/{name}"] = graphql.NewGraphqlTemplatesExecute(o.context, o.GraphqlGraphqlTemplatesExecuteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/graphql/templates"] = graphql.NewGraphqlTemplatesList(o.context, o.GraphqlGraphqlTemplatesListHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/graphql/templates/{name}"] = graphql.NewGraphqlTemplatesUpdate(o.context, o.GraphqlGraphqlTemplatesUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/authz/roles/{id}/has-permission"] = authz.NewHasPermission(o.context, o.AuthzHasPermissionHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/tenantactivity"
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
 	"github.com/weaviate/weaviate/adapters/repos/db"
+	modulestorage "github.com/weaviate/weaviate/adapters/repos/modules"
 	rCluster "github.com/weaviate/weaviate/cluster"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/anonymous"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
@@ -30,15 +31,19 @@ import (
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
 	configRuntime "github.com/weaviate/weaviate/usecases/config/runtime"
+	"github.com/weaviate/weaviate/usecases/masking"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/qos"
+	"github.com/weaviate/weaviate/usecases/querytemplates"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"github.com/weaviate/weaviate/usecases/traverser"
+	"github.com/weaviate/weaviate/usecases/usage"
 )
 
 // State is the only source of application-wide state
@@ -50,6 +55,11 @@ type State struct {
 	APIKey          *apikey.ApiKey
 	Authorizer      authorization.Authorizer
 	AuthzController authorization.Controller
+	PrincipalRoles  authorization.PrincipalRoles
+	Masker          *masking.Masker
+	UsageQueries    *usage.QueryCounter
+	QueryTemplates  *querytemplates.Templates
+	QoS             *qos.Scheduler
 
 	ServerConfig          *config.WeaviateConfig
 	LDIntegration         *configRuntime.LDIntegration
@@ -66,15 +76,21 @@ type State struct {
 	Traverser             *traverser.Traverser
 
 	ClassificationRepo *classifications.DistributedRepo
-	Metrics            *monitoring.PrometheusMetrics
-	HTTPServerMetrics  *monitoring.HTTPServerMetrics
-	GRPCServerMetrics  *monitoring.GRPCServerMetrics
-	BackupManager      *backup.Handler
-	DB                 *db.DB
-	BatchManager       *objects.BatchManager
-	ClusterHttpClient  *http.Client
-	ReindexCtxCancel   context.CancelFunc
-	MemWatch           *memwatch.Monitor
+	// SchemaRepo, LocalClassificationRepo and ModuleStorage are node-local
+	// bolt stores. The server releases them on exit, embedded instances close
+	// them explicitly.
+	SchemaRepo              interface{ Close() }
+	LocalClassificationRepo *classifications.Repo
+	ModuleStorage           *modulestorage.Repo
+	Metrics                 *monitoring.PrometheusMetrics
+	HTTPServerMetrics       *monitoring.HTTPServerMetrics
+	GRPCServerMetrics       *monitoring.GRPCServerMetrics
+	BackupManager           *backup.Handler
+	DB                      *db.DB
+	BatchManager            *objects.BatchManager
+	ClusterHttpClient       *http.Client
+	ReindexCtxCancel        context.CancelFunc
+	MemWatch                *memwatch.Monitor
 
 	ClusterService *rCluster.Service
 	TenantActivity *tenantactivity.Handler
@@ -48,6 +48,11 @@ func (r *Repo) DBPath() string {
 	return fmt.Sprintf("%s/classifications.db", r.baseDir)
 }
 
+// Close releases the underlying bolt db
+func (r *Repo) Close() error {
+	return r.db.Close()
+}
+
 func (r *Repo) keyFromID(id strfmt.UUID) []byte {
 	return []byte(id)
 }
@@ -819,3 +819,171 @@ func TestBM25F_SortMultiPropBlock(t *testing.T) {
 
 	}
 }
+
+func TestBM25FMinimumShouldMatchBlock(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	props := SetupClass(t, repo, schemaGetter, logger, 0.5, 100)
+
+	idx := repo.GetIndex("MyClass")
+	require.NotNil(t, idx)
+
+	docIDs := func(t *testing.T, query string, operator searchparams.KeywordOperator, properties ...string) []uint64 {
+		if len(properties) == 0 {
+			properties = []string{"description"}
+		}
+		kwr := &searchparams.KeywordRanking{
+			Type: "bm25", Properties: properties, Query: query, SearchOperator: operator,
+		}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, props)
+		require.Nil(t, err)
+		ids := make([]uint64, len(res))
+		for i := range res {
+			ids[i] = res[i].DocID
+		}
+		return ids
+	}
+
+	for _, location := range []string{"memory", "disk"} {
+		t.Run("without search operator "+location, func(t *testing.T) {
+			ids := docIDs(t, "journey story get", searchparams.KeywordOperator{})
+			assert.ElementsMatch(t, []uint64{0, 1, 2, 3, 4, 5, 6}, ids)
+		})
+
+		t.Run("minimum should match count "+location, func(t *testing.T) {
+			ids := docIDs(t, "journey story get", searchparams.KeywordOperator{
+				Operator: searchparams.KeywordOperatorOr, MinimumShouldMatch: "2",
+			})
+			assert.ElementsMatch(t, []uint64{2}, ids)
+		})
+
+		t.Run("minimum should match percentage "+location, func(t *testing.T) {
+			ids := docIDs(t, "journey story get", searchparams.KeywordOperator{
+				Operator: searchparams.KeywordOperatorOr, MinimumShouldMatch: "50%",
+			})
+			assert.ElementsMatch(t, []uint64{0, 1, 2, 3, 4, 5, 6}, ids)
+		})
+
+		t.Run("and operator "+location, func(t *testing.T) {
+			ids := docIDs(t, "loud journey", searchparams.KeywordOperator{
+				Operator: searchparams.KeywordOperatorAnd,
+			})
+			assert.ElementsMatch(t, []uint64{6}, ids)
+		})
+
+		t.Run("no document matches "+location, func(t *testing.T) {
+			ids := docIDs(t, "loud story", searchparams.KeywordOperator{
+				Operator: searchparams.KeywordOperatorAnd,
+			})
+			assert.Empty(t, ids)
+		})
+
+		t.Run("mixed tokenization "+location, func(t *testing.T) {
+			// the field tokenized property only has one query term, which
+			// must not lift the restriction on the word tokenized one
+			ids := docIDs(t, "journey story", searchparams.KeywordOperator{
+				Operator: searchparams.KeywordOperatorOr, MinimumShouldMatch: "2",
+			}, "description", "textField")
+			assert.ElementsMatch(t, []uint64{2}, ids)
+		})
+
+		t.Run("mixed tokenization matching a single term group "+location, func(t *testing.T) {
+			ids := docIDs(t, "YELLING IS FUN", searchparams.KeywordOperator{
+				Operator: searchparams.KeywordOperatorOr, MinimumShouldMatch: "2",
+			}, "description", "textField")
+			assert.ElementsMatch(t, []uint64{8}, ids)
+		})
+
+		for _, index := range repo.indices {
+			index.ForEachShard(func(name string, shard ShardLike) error {
+				err := shard.Store().FlushMemtables(context.Background())
+				require.Nil(t, err)
+				return nil
+			})
+		}
+	}
+}
+
+func TestBM25FKeywordOverridesBlock(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	className, props := SetupClassDocuments(t, repo, schemaGetter, logger, 0.5, 0.75, "en")
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+
+	search := func(kwr *searchparams.KeywordRanking) ([]uint64, error) {
+		res, _, err := idx.objectSearch(context.TODO(), 10, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, props)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]uint64, len(res))
+		for i := range res {
+			ids[i] = res[i].DocID
+		}
+		return ids, nil
+	}
+
+	for _, location := range []string{"memory", "disk"} {
+		t.Run("stopwords of the class "+location, func(t *testing.T) {
+			ids, err := search(&searchparams.KeywordRanking{Type: "bm25", Query: "the"})
+			require.Nil(t, err)
+			assert.Empty(t, ids)
+		})
+
+		t.Run("stopwords override "+location, func(t *testing.T) {
+			ids, err := search(&searchparams.KeywordRanking{
+				Type: "bm25", Query: "the", Stopwords: &models.StopwordConfig{Preset: "none"},
+			})
+			require.Nil(t, err)
+			assert.ElementsMatch(t, []uint64{0, 2, 3}, ids)
+		})
+
+		t.Run("stopwords override with additions "+location, func(t *testing.T) {
+			ids, err := search(&searchparams.KeywordRanking{
+				Type: "bm25", Query: "test", Stopwords: &models.StopwordConfig{Preset: "en", Additions: []string{"test"}},
+			})
+			require.Nil(t, err)
+			assert.Empty(t, ids)
+		})
+
+		for _, index := range repo.indices {
+			index.ForEachShard(func(name string, shard ShardLike) error {
+				err := shard.Store().FlushMemtables(context.Background())
+				require.Nil(t, err)
+				return nil
+			})
+		}
+	}
+}
@@ -303,6 +303,96 @@ func TestUpdateJourney(t *testing.T) {
 	})
 }
 
+// An update re-vectorizes an object only if its text changed. Otherwise the
+// vector of the stored object is kept, which is read together with the text
+// properties to compare. A projection on the other hand skips the vectors.
+func TestUpdateWithUnchangedText(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	require.Nil(t, migrator.AddClass(context.Background(), updateTestClass(), schemaGetter.shardState))
+	schemaGetter.schema = libschema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{updateTestClass()},
+		},
+	}
+
+	stored := updateTestData()[0]
+	require.Nil(t, repo.PutObject(context.Background(), stored.Object(), stored.Vector, nil, nil, nil, 0))
+
+	textProps := search.SelectProperties{{Name: "name", IsPrimitive: true}}
+
+	t.Run("update without changing the text", func(t *testing.T) {
+		// the stored object as it is read to compare its text
+		old, err := repo.Object(context.Background(), stored.ClassName, stored.ID,
+			textProps, additional.Properties{}, nil, "")
+		require.Nil(t, err)
+		require.NotNil(t, old)
+		assert.Equal(t, "element-0", old.Schema.(map[string]interface{})["name"])
+		require.Equal(t, stored.Vector, old.Vector)
+
+		old, err = repo.ObjectByID(context.Background(), stored.ID, textProps, additional.Properties{}, "")
+		require.Nil(t, err)
+		require.Equal(t, stored.Vector, old.Vector)
+
+		updated := stored.Object()
+		updated.Properties = map[string]interface{}{
+			"intProp": int64(7),
+			"name":    "element-0",
+		}
+		require.Nil(t, repo.PutObject(context.Background(), updated, old.Vector, nil, nil, nil, 0))
+
+		res, err := repo.Object(context.Background(), stored.ClassName, stored.ID,
+			search.SelectProperties{}, additional.Properties{Vector: true}, nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, float64(7), res.Schema.(map[string]interface{})["intProp"])
+		assert.Equal(t, stored.Vector, res.Vector)
+	})
+
+	t.Run("projection", func(t *testing.T) {
+		res, err := repo.Object(context.Background(), stored.ClassName, stored.ID,
+			textProps, additional.Properties{Projected: true}, nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, "element-0", res.Schema.(map[string]interface{})["name"])
+		assert.NotContains(t, res.Schema, "intProp")
+		assert.Nil(t, res.Vector)
+
+		res, err = repo.ObjectByID(context.Background(), stored.ID,
+			nil, additional.Properties{Projected: true}, "")
+		require.Nil(t, err)
+		assert.Contains(t, res.Schema, "intProp")
+		assert.Nil(t, res.Vector)
+
+		res, err = repo.Object(context.Background(), stored.ClassName, stored.ID,
+			textProps, additional.Properties{Projected: true, Vector: true}, nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, stored.Vector, res.Vector)
+
+		list, err := repo.ObjectSearch(context.Background(), 0, 10, nil, nil,
+			additional.Properties{Projected: true}, "")
+		require.Nil(t, err)
+		require.Len(t, list, 1)
+		assert.Contains(t, list[0].Schema, "intProp")
+		assert.Nil(t, list[0].Vector)
+	})
+}
+
 func updateTestClass() *models.Class {
 	return &models.Class{
 		Class:             "UpdateTestClass",
@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// asciiFoldReplacer handles letters which aren't composed of a base letter
+// and a diacritic, and therefore aren't folded by removing the marks
+var asciiFoldReplacer = strings.NewReplacer(
+	"ł", "l", "Ł", "L",
+	"đ", "d", "Đ", "D",
+	"ø", "o", "Ø", "O",
+	"ħ", "h", "Ħ", "H",
+	"ı", "i",
+	"ß", "ss", "ẞ", "SS",
+	"æ", "ae", "Æ", "AE",
+	"œ", "oe", "Œ", "OE",
+	"þ", "th", "Þ", "TH",
+)
+
+// AnalyzeText normalizes a text value according to the text analyzer config
+// of its property. It has to be applied before tokenization, both when the
+// value is indexed and when it's queried, so that the terms match.
+func AnalyzeText(analyzer *models.TextAnalyzerConfig, in string) string {
+	if analyzer == nil {
+		return in
+	}
+	if analyzer.ASCIIFold {
+		in = asciiFold(in)
+	}
+	if analyzer.CaseFold {
+		in = strings.ToLower(in)
+	}
+	return in
+}
+
+// AnalyzeTexts applies AnalyzeText to every value
+func AnalyzeTexts(analyzer *models.TextAnalyzerConfig, in []string) []string {
+	if analyzer == nil || (!analyzer.ASCIIFold && !analyzer.CaseFold) {
+		return in
+	}
+	out := make([]string, len(in))
+	for i := range in {
+		out[i] = AnalyzeText(analyzer, in[i])
+	}
+	return out
+}
+
+func asciiFold(in string) string {
+	// the transformer isn't safe for concurrent use, so it's created per call
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(t, in)
+	if err != nil {
+		folded = in
+	}
+	return asciiFoldReplacer.Replace(folded)
+}
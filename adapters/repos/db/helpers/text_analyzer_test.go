@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestAnalyzeText(t *testing.T) {
+	asciiFold := &models.TextAnalyzerConfig{ASCIIFold: true}
+	caseFold := &models.TextAnalyzerConfig{CaseFold: true}
+	both := &models.TextAnalyzerConfig{ASCIIFold: true, CaseFold: true}
+
+	tests := []struct {
+		analyzer *models.TextAnalyzerConfig
+		in       string
+		expected string
+	}{
+		{analyzer: nil, in: "Łódź", expected: "Łódź"},
+		{analyzer: &models.TextAnalyzerConfig{}, in: "Łódź", expected: "Łódź"},
+		{analyzer: asciiFold, in: "Łódź", expected: "Lodz"},
+		{analyzer: asciiFold, in: "Crème Brûlée", expected: "Creme Brulee"},
+		{analyzer: asciiFold, in: "Straße Øresund Ærø", expected: "Strasse Oresund AEro"},
+		{analyzer: asciiFold, in: "naïve*caf?", expected: "naive*caf?"},
+		{analyzer: caseFold, in: "ABC-123", expected: "abc-123"},
+		{analyzer: both, in: "Łódź", expected: "lodz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.expected, AnalyzeText(tt.analyzer, tt.in))
+		})
+	}
+
+	t.Run("field tokenization matches regardless of case and accents", func(t *testing.T) {
+		indexed := Tokenize(models.PropertyTokenizationField, AnalyzeText(both, "Łódź"))
+		queried := Tokenize(models.PropertyTokenizationField, AnalyzeText(both, "lodz"))
+		assert.Equal(t, indexed, queried)
+	})
+
+	t.Run("all values", func(t *testing.T) {
+		assert.Equal(t, []string{"lodz", "krakow"}, AnalyzeTexts(both, []string{"Łódź", "Kraków"}))
+	})
+}
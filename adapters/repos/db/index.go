@@ -1130,6 +1130,9 @@ func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 		if replProps == nil {
 			replProps = defaultConsistency()
 		}
+		// the object read may be used to repair other replicas, so it must be
+		// read in full
+		addl.Projected = false
 		if replProps.NodeName != "" {
 			obj, err = i.replicator.NodeObject(ctx, replProps.NodeName, shardName, id, props, addl)
 		} else {
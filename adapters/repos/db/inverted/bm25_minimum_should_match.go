@@ -0,0 +1,36 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// requiresMinimumShouldMatch returns true if the search operator requires
+// documents to match more than one distinct query term in any tokenization
+// group. Query terms are already deduplicated by the tokenizer. A group with
+// a single required term still admits all documents containing any of its
+// terms, e.g. a field tokenized property next to word tokenized ones.
+func requiresMinimumShouldMatch(operator searchparams.KeywordOperator,
+	propNamesByTokenization map[string][]string, queryTermsByTokenization map[string][]string,
+) bool {
+	for tokenization, propNames := range propNamesByTokenization {
+		if len(propNames) == 0 {
+			continue
+		}
+		if queryTerms := queryTermsByTokenization[tokenization]; len(queryTerms) > 1 &&
+			operator.RequiredTerms(len(queryTerms)) > 1 {
+			return true
+		}
+	}
+	return false
+}
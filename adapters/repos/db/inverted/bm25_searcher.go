@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"math"
 	"runtime/debug"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -118,10 +119,14 @@ func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params se
 	// which would require the old WAND implementation.
 	allBucketsAreInverted := true
 
+	stopwordConfig := params.Stopwords
+	if stopwordConfig == nil && class.InvertedIndexConfig != nil {
+		stopwordConfig = class.InvertedIndexConfig.Stopwords
+	}
 	var stopWordDetector *stopwords.Detector
-	if class.InvertedIndexConfig != nil && class.InvertedIndexConfig.Stopwords != nil {
+	if stopwordConfig != nil {
 		var err error
-		stopWordDetector, err = stopwords.NewDetectorFromConfig(*(class.InvertedIndexConfig.Stopwords))
+		stopWordDetector, err = stopwords.NewDetectorFromConfig(*stopwordConfig)
 		if err != nil {
 			return false, 0, nil, nil, nil, nil, 0, err
 		}
@@ -136,19 +141,19 @@ func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params se
 	propNamesByTokenization := map[string][]string{}
 	propertyBoosts := make(map[string]float32, len(params.Properties))
 
-	for _, tokenization := range helpers.Tokenizations {
-		queryTerms, dupBoosts := helpers.TokenizeAndCountDuplicates(tokenization, params.Query)
-		queryTermsByTokenization[tokenization] = queryTerms
-		duplicateBoostsByTokenization[tokenization] = dupBoosts
+	tokenizeQuery := func(tokenization string, analyzer *models.TextAnalyzerConfig) ([]string, []int) {
+		queryTerms, dupBoosts := helpers.TokenizeAndCountDuplicates(tokenization,
+			helpers.AnalyzeText(analyzer, params.Query))
 
 		// stopword filtering for word tokenization
 		if tokenization == models.PropertyTokenizationWord {
-			queryTerms, dupBoosts = b.removeStopwordsFromQueryTerms(queryTermsByTokenization[tokenization],
-				duplicateBoostsByTokenization[tokenization], stopWordDetector)
-			queryTermsByTokenization[tokenization] = queryTerms
-			duplicateBoostsByTokenization[tokenization] = dupBoosts
+			queryTerms, dupBoosts = b.removeStopwordsFromQueryTerms(queryTerms, dupBoosts, stopWordDetector)
 		}
+		return queryTerms, dupBoosts
+	}
 
+	for _, tokenization := range helpers.Tokenizations {
+		queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization] = tokenizeQuery(tokenization, nil)
 		propNamesByTokenization[tokenization] = make([]string, 0)
 	}
 
@@ -198,7 +203,13 @@ func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params se
 				return false, 0, nil, nil, nil, nil, 0, fmt.Errorf("cannot handle tokenization '%v' of property '%s'",
 					prop.Tokenization, prop.Name)
 			}
-			propNamesByTokenization[prop.Tokenization] = append(propNamesByTokenization[prop.Tokenization], property)
+			// properties with a text analyzer are grouped separately, as the
+			// query has to be analyzed the same way
+			key := queryTermsKey(prop.Tokenization, prop.TextAnalyzer)
+			if _, exists := queryTermsByTokenization[key]; !exists {
+				queryTermsByTokenization[key], duplicateBoostsByTokenization[key] = tokenizeQuery(prop.Tokenization, prop.TextAnalyzer)
+			}
+			propNamesByTokenization[key] = append(propNamesByTokenization[key], property)
 		default:
 			return false, 0, nil, nil, nil, nil, 0, fmt.Errorf("cannot handle datatype '%v' of property '%s'", dt, prop.Name)
 		}
@@ -216,6 +227,26 @@ func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params se
 	return allBucketsAreInverted, N, propNamesByTokenization, queryTermsByTokenization, duplicateBoostsByTokenization, propertyBoosts, averagePropLength, nil
 }
 
+// queryTermsKey groups properties which are matched against the same query
+// terms
+func queryTermsKey(tokenization string, analyzer *models.TextAnalyzerConfig) string {
+	if analyzer == nil || (!analyzer.ASCIIFold && !analyzer.CaseFold) {
+		return tokenization
+	}
+	return fmt.Sprintf("%s/asciiFold=%t/caseFold=%t", tokenization, analyzer.ASCIIFold, analyzer.CaseFold)
+}
+
+// sortedQueryTermsKeys returns the keys of propNamesByTokenization in a
+// stable order
+func sortedQueryTermsKeys(propNamesByTokenization map[string][]string) []string {
+	keys := make([]string, 0, len(propNamesByTokenization))
+	for key := range propNamesByTokenization {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func (b *BM25Searcher) wand(
 	ctx context.Context, filterDocIds helpers.AllowList, class *models.Class, params searchparams.KeywordRanking, limit int, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
@@ -226,12 +257,21 @@ func (b *BM25Searcher) wand(
 
 	allRequests := make([]termListRequest, 0, 1000)
 	allQueryTerms := make([]string, 0, 1000)
+	// the terms of every tokenization are a group for the search operator
+	termGroups := make([]int, 0, 1000)
+	requiredTerms := make([]int, 0, len(propNamesByTokenization))
+	restricted := false
 
-	for _, tokenization := range helpers.Tokenizations {
+	for _, tokenization := range sortedQueryTermsKeys(propNamesByTokenization) {
 		propNames := propNamesByTokenization[tokenization]
 		if len(propNames) > 0 {
 			queryTerms, duplicateBoosts := queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization]
+			required := params.SearchOperator.RequiredTerms(len(queryTerms))
+			restricted = restricted || required > 1
+			group := len(requiredTerms)
+			requiredTerms = append(requiredTerms, required)
 			for queryTermIndex, queryTerm := range queryTerms {
+				termGroups = append(termGroups, group)
 				allRequests = append(allRequests, termListRequest{
 					term:               queryTerm,
 					termId:             len(allRequests),
@@ -302,6 +342,10 @@ func (b *BM25Searcher) wand(
 		T:     resultsNonNil,
 		Count: len(allRequests),
 	}
+	if restricted {
+		combinedTerms.TermGroups = termGroups
+		combinedTerms.RequiredTerms = requiredTerms
+	}
 
 	topKHeap := lsmkv.DoWand(limit, combinedTerms, averagePropLength, params.AdditionalExplanations)
 
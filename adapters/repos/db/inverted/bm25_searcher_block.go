@@ -56,7 +56,9 @@ func (b *BM25Searcher) wandBlock(
 		return nil, nil, err
 	}
 
-	if !allBucketsAreInverted {
+	// block max wand scores every property and segment on its own, so it
+	// cannot count the terms a document matches in total
+	if !allBucketsAreInverted || requiresMinimumShouldMatch(params.SearchOperator, propNamesByTokenization, queryTermsByTokenization) {
 		return b.wand(ctx, filterDocIds, class, params, limit, additional)
 	}
 
@@ -76,7 +78,7 @@ func (b *BM25Searcher) wandBlock(
 		}
 	}()
 
-	for _, tokenization := range helpers.Tokenizations {
+	for _, tokenization := range sortedQueryTermsKeys(propNamesByTokenization) {
 		propNames := propNamesByTokenization[tokenization]
 		if len(propNames) > 0 {
 			lenAllResults := len(allResults)
@@ -30,6 +30,40 @@ import (
 func TestAnalyzeObject(t *testing.T) {
 	a := NewAnalyzer(nil)
 
+	t.Run("with text analyzer", func(t *testing.T) {
+		sch := map[string]interface{}{
+			"city":   "Łódź",
+			"cities": []string{"Kraków", "GDAŃSK"},
+		}
+		analyzer := &models.TextAnalyzerConfig{ASCIIFold: true, CaseFold: true}
+		props := []*models.Property{
+			{
+				Name:         "city",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+				TextAnalyzer: analyzer,
+			},
+			{
+				Name:         "cities",
+				DataType:     schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+				TextAnalyzer: analyzer,
+			},
+		}
+
+		res, err := a.Object(sch, props, strfmt.UUID("2609f1bc-7693-48f3-b531-6ddc52cd2501"))
+		require.Nil(t, err)
+
+		items := map[string][]string{}
+		for _, prop := range res {
+			for _, item := range prop.Items {
+				items[prop.Name] = append(items[prop.Name], string(item.Data))
+			}
+		}
+		assert.ElementsMatch(t, []string{"lodz"}, items["city"])
+		assert.ElementsMatch(t, []string{"krakow", "gdansk"}, items["cities"])
+	})
+
 	t.Run("with multiple properties", func(t *testing.T) {
 		id1 := uuid.New()
 		id2 := uuid.New()
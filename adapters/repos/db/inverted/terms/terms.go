@@ -313,6 +313,13 @@ func (t *Term) CurrentBlockMaxId() uint64 {
 type Terms struct {
 	T     []TermInterface
 	Count int
+
+	// TermGroups maps the query term index of every term to its group and
+	// RequiredTerms holds the number of distinct terms a document needs to
+	// match in a group. A document qualifies if it satisfies the requirement
+	// of any group. Without RequiredTerms all documents qualify.
+	TermGroups    []int
+	RequiredTerms []int
 }
 
 func (t *Terms) CompletelyExhausted() bool {
@@ -394,23 +401,30 @@ func (t *Terms) FindFirstNonExhausted() (int, bool) {
 	return -1, false
 }
 
-func (t *Terms) ScoreNext(averagePropLength float64, additionalExplanations bool) (uint64, float64, []*DocPointerWithScore) {
+// ScoreNext scores and advances all terms pointing to the lowest id. The
+// returned bool reports if the document matches enough terms to qualify.
+func (t *Terms) ScoreNext(averagePropLength float64, additionalExplanations bool) (uint64, float64, []*DocPointerWithScore, bool) {
 	var docInfos []*DocPointerWithScore
 
 	pos, ok := t.FindFirstNonExhausted()
 	if !ok {
 		// done, nothing left to score
-		return 0, 0, docInfos
+		return 0, 0, docInfos, false
 	}
 
 	if len(t.T) == 0 {
-		return 0, 0, docInfos
+		return 0, 0, docInfos, false
 	}
 
 	if additionalExplanations {
 		docInfos = make([]*DocPointerWithScore, t.Count)
 	}
 
+	var matched []int
+	if t.RequiredTerms != nil {
+		matched = make([]int, len(t.RequiredTerms))
+	}
+
 	id := t.T[pos].IdPointer()
 	var cumScore float64
 	for i := pos; i < len(t.T); i++ {
@@ -423,11 +437,26 @@ func (t *Terms) ScoreNext(averagePropLength float64, additionalExplanations bool
 		if additionalExplanations {
 			docInfos[term.QueryTermIndex()] = docInfo
 		}
+		if matched != nil {
+			matched[t.TermGroups[term.QueryTermIndex()]]++
+		}
 		cumScore += score
 	}
 
 	// t.FullSort()
-	return id, cumScore, docInfos
+	return id, cumScore, docInfos, t.qualifies(matched)
+}
+
+func (t *Terms) qualifies(matched []int) bool {
+	if t.RequiredTerms == nil {
+		return true
+	}
+	for group, required := range t.RequiredTerms {
+		if matched[group] >= required {
+			return true
+		}
+	}
+	return false
 }
 
 // provide sort interface
@@ -1088,6 +1088,13 @@ func (b *Bucket) setNewActiveMemtable() error {
 	return nil
 }
 
+// DiskSize is the size of the flushed segments of the bucket, the same
+// segments that are reported as lsm_segment_size. Memtables and their WAL
+// are not included.
+func (b *Bucket) DiskSize() int64 {
+	return b.disk.Size()
+}
+
 func (b *Bucket) Count() int {
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
@@ -183,9 +183,9 @@ func DoWand(limit int, results *terms.Terms, averagePropLength float64, addition
 			return topKHeap
 		}
 
-		id, score, additional := results.ScoreNext(averagePropLength, additionalExplanations)
+		id, score, additional, qualifies := results.ScoreNext(averagePropLength, additionalExplanations)
 		results.SortFull()
-		if topKHeap.ShouldEnqueue(float32(score), limit) {
+		if qualifies && topKHeap.ShouldEnqueue(float32(score), limit) {
 			topKHeap.InsertAndPop(id, score, limit, &worstDist, additional)
 		}
 	}
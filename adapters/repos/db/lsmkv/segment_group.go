@@ -653,6 +653,19 @@ func (sg *SegmentGroup) count() int {
 	return count
 }
 
+// Size is the sum of the sizes of all segments on disk
+func (sg *SegmentGroup) Size() int64 {
+	segments, release := sg.getAndLockSegments()
+	defer release()
+
+	var size int64
+	for _, seg := range segments {
+		size += int64(seg.Size())
+	}
+
+	return size
+}
+
 func (sg *SegmentGroup) shutdown(ctx context.Context) error {
 	if err := sg.compactionCallbackCtrl.Unregister(ctx); err != nil {
 		return fmt.Errorf("long-running compaction in progress: %w", ctx.Err())
@@ -81,6 +81,20 @@ func New(dir, rootDir string, logger logrus.FieldLogger, metrics *Metrics,
 	return s, s.init()
 }
 
+// DiskSize sums up the DiskSize of all buckets of the store
+func (s *Store) DiskSize() int64 {
+	s.bucketAccessLock.RLock()
+	defer s.bucketAccessLock.RUnlock()
+
+	var size int64
+	for _, b := range s.bucketsByName {
+		if b != nil {
+			size += b.DiskSize()
+		}
+	}
+	return size
+}
+
 func (s *Store) Bucket(name string) *Bucket {
 	s.bucketAccessLock.RLock()
 	defer s.bucketAccessLock.RUnlock()
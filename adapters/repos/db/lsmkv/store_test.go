@@ -14,6 +14,7 @@ package lsmkv
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 
@@ -110,3 +111,29 @@ func TestCreateBucketConcurrency(t *testing.T) {
 	mockBucketCreator.AssertNumberOfCalls(t, "NewBucket", 1)
 	mockBucketCreator.AssertExpectations(t)
 }
+
+func TestStoreDiskSize(t *testing.T) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	ctx := context.Background()
+
+	store, err := New(dirName, dirName, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	defer store.Shutdown(ctx)
+
+	require.Nil(t, store.CreateOrLoadBucket(ctx, "objects", WithStrategy(StrategyReplace)))
+	bucket := store.Bucket("objects")
+	require.Nil(t, bucket.Put([]byte("key"), []byte("value")))
+	require.Equal(t, int64(0), store.DiskSize(), "memtables are not counted")
+
+	require.Nil(t, bucket.FlushAndSwitch())
+	segments, err := filepath.Glob(filepath.Join(dirName, "objects", "*.db"))
+	require.Nil(t, err)
+	require.Len(t, segments, 1)
+	info, err := os.Stat(segments[0])
+	require.Nil(t, err)
+	require.Equal(t, info.Size(), store.DiskSize())
+}
@@ -236,7 +236,7 @@ func (db *DB) Query(ctx context.Context, q *objects.QueryInput) (search.Results,
 		}
 	}
 	res, _, err := idx.objectSearch(ctx, totalLimit, q.Filters,
-		nil, q.Sort, q.Cursor, q.Additional, nil, q.Tenant, 0, nil)
+		nil, q.Sort, q.Cursor, q.Additional, nil, q.Tenant, 0, q.Properties)
 	if err != nil {
 		switch {
 		case errors.As(err, &objects.ErrMultiTenancy{}):
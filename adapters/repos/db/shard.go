@@ -130,6 +130,8 @@ type ShardLike interface {
 	abortReplication(context.Context, string) replica.SimpleResponse
 	filePutter(context.Context, string) (io.WriteCloser, error)
 
+	VectorCount(ctx context.Context, targetVector string) (count, dimensionality int)
+
 	// TODO tests only
 	Dimensions(ctx context.Context, targetVector string) int // dim(vector)*number vectors
 	QuantizedDimensions(ctx context.Context, targetVector string, segments int) int
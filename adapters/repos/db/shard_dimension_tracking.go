@@ -51,6 +51,14 @@ func (s *Shard) QuantizedDimensions(ctx context.Context, targetVector string, se
 	return sum * correctEmptySegments(segments, dimensions)
 }
 
+// VectorCount returns the number of vectors of the target vector and their
+// length
+func (s *Shard) VectorCount(ctx context.Context, targetVector string) (count, dimensionality int) {
+	return s.calcTargetVectorDimensions(ctx, targetVector, func(dimLength int, v []lsmkv.MapPair) (int, int) {
+		return len(v), dimLength
+	})
+}
+
 func (s *Shard) calcTargetVectorDimensions(ctx context.Context, targetVector string, calcEntry func(dimLen int, v []lsmkv.MapPair) (int, int)) (sum int, dimensions int) {
 	b := s.store.Bucket(helpers.DimensionsBucketLSM)
 	if b == nil {
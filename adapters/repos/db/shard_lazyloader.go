@@ -57,6 +57,10 @@ type LazyLoadShard struct {
 	mutex            sync.Mutex
 	memMonitor       memwatch.AllocChecker
 	shardLoadLimiter ShardLoadLimiter
+
+	// unloadedDiskSize caches the size of the shard before it is loaded,
+	// during which it can't change
+	unloadedDiskSize *int64
 }
 
 func NewLazyLoadShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
@@ -416,6 +420,11 @@ func (l *LazyLoadShard) QuantizedDimensions(ctx context.Context, targetVector st
 	return l.shard.QuantizedDimensions(ctx, targetVector, segments)
 }
 
+func (l *LazyLoadShard) VectorCount(ctx context.Context, targetVector string) (int, int) {
+	l.mustLoad()
+	return l.shard.VectorCount(ctx, targetVector)
+}
+
 func (l *LazyLoadShard) publishDimensionMetrics(ctx context.Context) {
 	l.mustLoad()
 	l.shard.publishDimensionMetrics(ctx)
@@ -696,6 +705,27 @@ func (l *LazyLoadShard) isLoaded() bool {
 	return l.loaded
 }
 
+// diskSize returns the size of the segments of a loaded shard, or the size of
+// the shard directory if it is not loaded yet. It reports whether the shard
+// is loaded.
+func (l *LazyLoadShard) diskSize() (int64, bool, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.loaded {
+		return l.shard.Store().DiskSize(), true, nil
+	}
+
+	if l.unloadedDiskSize == nil {
+		size, err := dirSize(shardPath(l.shardOpts.index.path(), l.shardOpts.name))
+		if err != nil {
+			return 0, false, err
+		}
+		l.unloadedDiskSize = &size
+	}
+	return *l.unloadedDiskSize, false, nil
+}
+
 func (l *LazyLoadShard) Activity() int32 {
 	var loaded bool
 	l.mutex.Lock()
@@ -80,7 +80,7 @@ func (s *Shard) ObjectByID(ctx context.Context, id strfmt.UUID, props search.Sel
 		return nil, nil
 	}
 
-	obj, err := storobj.FromBinary(bytes)
+	obj, err := unmarshalSelectedProperties(bytes, props.GetPropertyNames(), additional)
 	if err != nil {
 		return nil, errors.Wrap(err, "unmarshal object")
 	}
@@ -88,6 +88,26 @@ func (s *Shard) ObjectByID(ctx context.Context, id strfmt.UUID, props search.Sel
 	return obj, nil
 }
 
+// unmarshalSelectedProperties unmarshals an object read from the objects
+// bucket. Objects read for a projection only get the selected properties, or
+// all of them if none are selected, and their vectors are skipped unless they
+// are needed for the response. Any other read gets the whole object.
+func unmarshalSelectedProperties(data []byte, properties []string,
+	addl additional.Properties,
+) (*storobj.Object, error) {
+	// module params such as nearestNeighbors or featureProjection are
+	// computed from the vector of the object
+	if !addl.Projected || addl.Vector || len(addl.ModuleParams) > 0 {
+		return storobj.FromBinary(data)
+	}
+
+	var extraction *storobj.PropertyExtraction
+	if len(properties) > 0 {
+		extraction = storobj.NewPropExtraction().Add(properties...)
+	}
+	return storobj.FromBinaryOptional(data, addl, extraction)
+}
+
 func (s *Shard) MultiObjectByID(ctx context.Context, query []multi.Identifier) ([]*storobj.Object, error) {
 	s.activityTracker.Add(1)
 	objects := make([]*storobj.Object, len(query))
@@ -331,8 +351,8 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 	}
 
 	if filters == nil {
-		objs, err := s.ObjectList(ctx, limit, sort,
-			cursor, additional, s.index.Config.ClassName)
+		objs, err := s.objectList(ctx, limit, sort,
+			cursor, additional, properties, s.index.Config.ClassName)
 		return objs, nil, err
 	}
 	objs, err := inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.ReadOnlyClass,
@@ -552,6 +572,12 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 }
 
 func (s *Shard) ObjectList(ctx context.Context, limit int, sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties, className schema.ClassName) ([]*storobj.Object, error) {
+	return s.objectList(ctx, limit, sort, cursor, additional, nil, className)
+}
+
+func (s *Shard) objectList(ctx context.Context, limit int, sort []filters.Sort, cursor *filters.Cursor,
+	additional additional.Properties, properties []string, className schema.ClassName,
+) ([]*storobj.Object, error) {
 	s.activityTracker.Add(1)
 	if len(sort) > 0 {
 		docIDs, err := s.sortedObjectList(ctx, limit, sort, className)
@@ -559,17 +585,17 @@ func (s *Shard) ObjectList(ctx context.Context, limit int, sort []filters.Sort,
 			return nil, err
 		}
 		bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-		return storobj.ObjectsByDocID(bucket, docIDs, additional, nil, s.index.logger)
+		return storobj.ObjectsByDocID(bucket, docIDs, additional, properties, s.index.logger)
 	}
 
 	if cursor == nil {
 		cursor = &filters.Cursor{After: "", Limit: limit}
 	}
-	return s.cursorObjectList(ctx, cursor, additional, className)
+	return s.cursorObjectList(ctx, cursor, additional, properties, className)
 }
 
 func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
-	additional additional.Properties,
+	additional additional.Properties, properties []string,
 	className schema.ClassName,
 ) ([]*storobj.Object, error) {
 	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
@@ -594,7 +620,7 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 	out := make([]*storobj.Object, c.Limit)
 
 	for ; key != nil && i < c.Limit; key, val = cursor.Next() {
-		obj, err := storobj.FromBinary(val)
+		obj, err := unmarshalSelectedProperties(val, properties, additional)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarhsal item %d", i)
 		}
@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/weaviate/weaviate/usecases/usage"
+)
+
+// LocalUsage collects the usage of all shards on this node. Just like the
+// node status, it does not force load lazy shards.
+func (db *DB) LocalUsage(ctx context.Context) ([]*usage.CollectionUsage, error) {
+	db.indexLock.RLock()
+	indices := make([]*Index, 0, len(db.indices))
+	for _, idx := range db.indices {
+		if idx != nil {
+			indices = append(indices, idx)
+		}
+	}
+	db.indexLock.RUnlock()
+
+	collections := make([]*usage.CollectionUsage, 0, len(indices))
+	for _, idx := range indices {
+		col, err := idx.usage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		collections = append(collections, col)
+	}
+
+	sort.Slice(collections, func(i, j int) bool {
+		return collections[i].Name < collections[j].Name
+	})
+	return collections, nil
+}
+
+func (i *Index) usage(ctx context.Context) (*usage.CollectionUsage, error) {
+	col := &usage.CollectionUsage{
+		Name:         i.Config.ClassName.String(),
+		MultiTenancy: i.partitioningEnabled,
+		Shards:       []*usage.ShardUsage{},
+	}
+
+	targetVectors := make([]string, 0)
+	for targetVector := range i.GetVectorIndexConfigs() {
+		targetVectors = append(targetVectors, targetVector)
+	}
+	sort.Strings(targetVectors)
+
+	err := i.ForEachShard(func(name string, shard ShardLike) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		storage, loaded := int64(0), true
+		if lazy, ok := shard.(*LazyLoadShard); ok {
+			var err error
+			if storage, loaded, err = lazy.diskSize(); err != nil {
+				return err
+			}
+		} else {
+			storage = shard.Store().DiskSize()
+		}
+
+		shardUsage := &usage.ShardUsage{
+			Name:         name,
+			Loaded:       loaded,
+			StorageBytes: storage,
+			Vectors:      []*usage.VectorUsage{},
+		}
+		col.Shards = append(col.Shards, shardUsage)
+		if !loaded {
+			return nil
+		}
+
+		shardUsage.ObjectCount = int64(shard.ObjectCountAsync())
+		for _, targetVector := range targetVectors {
+			count, dimensionality := shard.VectorCount(ctx, targetVector)
+			shardUsage.Vectors = append(shardUsage.Vectors, &usage.VectorUsage{
+				Name:           targetVector,
+				Dimensionality: int64(dimensionality),
+				Count:          int64(count),
+				Dimensions:     int64(count) * int64(dimensionality),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(col.Shards, func(a, b int) bool {
+		return col.Shards[a].Name < col.Shards[b].Name
+	})
+	return col, nil
+}
+
+// dirSize sums up the size of all files below path. It is only used for
+// shards which are not loaded, loaded shards know the size of their segments.
+func dirSize(path string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
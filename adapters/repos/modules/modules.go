@@ -41,6 +41,11 @@ func (r *Repo) DBPath() string {
 	return fmt.Sprintf("%s/modules.db", r.baseDir)
 }
 
+// Close releases the underlying bolt db
+func (r *Repo) Close() error {
+	return r.db.Close()
+}
+
 func (r *Repo) DataPath() string {
 	return r.baseDir
 }
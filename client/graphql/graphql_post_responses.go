@@ -58,6 +58,12 @@ func (o *GraphqlPostReader) ReadResponse(response runtime.ClientResponse, consum
 			return nil, err
 		}
 		return nil, result
+	case 429:
+		result := NewGraphqlPostTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewGraphqlPostInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -329,6 +335,74 @@ func (o *GraphqlPostUnprocessableEntity) readResponse(response runtime.ClientRes
 	return nil
 }
 
+// NewGraphqlPostTooManyRequests creates a GraphqlPostTooManyRequests with default headers values
+func NewGraphqlPostTooManyRequests() *GraphqlPostTooManyRequests {
+	return &GraphqlPostTooManyRequests{}
+}
+
+/*
+GraphqlPostTooManyRequests describes a response with status code 429, with default header values.
+
+None of the queries were admitted by their QoS class, because too many queries are running or queued. Retry it later.
+*/
+type GraphqlPostTooManyRequests struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this graphql post too many requests response has a 2xx status code
+func (o *GraphqlPostTooManyRequests) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this graphql post too many requests response has a 3xx status code
+func (o *GraphqlPostTooManyRequests) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this graphql post too many requests response has a 4xx status code
+func (o *GraphqlPostTooManyRequests) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this graphql post too many requests response has a 5xx status code
+func (o *GraphqlPostTooManyRequests) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this graphql post too many requests response a status code equal to that given
+func (o *GraphqlPostTooManyRequests) IsCode(code int) bool {
+	return code == 429
+}
+
+// Code gets the status code for the graphql post too many requests response
+func (o *GraphqlPostTooManyRequests) Code() int {
+	return 429
+}
+
+func (o *GraphqlPostTooManyRequests) Error() string {
+	return fmt.Sprintf("[POST /graphql][%d] graphqlPostTooManyRequests  %+v", 429, o.Payload)
+}
+
+func (o *GraphqlPostTooManyRequests) String() string {
+	return fmt.Sprintf("[POST /graphql][%d] graphqlPostTooManyRequests  %+v", 429, o.Payload)
+}
+
+func (o *GraphqlPostTooManyRequests) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GraphqlPostTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewGraphqlPostInternalServerError creates a GraphqlPostInternalServerError with default headers values
 func NewGraphqlPostInternalServerError() *GraphqlPostInternalServerError {
 	return &GraphqlPostInternalServerError{}
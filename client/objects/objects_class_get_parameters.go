@@ -81,6 +81,12 @@ type ObjectsClassGetParams struct {
 	*/
 	ConsistencyLevel *string
 
+	/* ExcludeProperties.
+
+	   Comma-separated list of the properties to leave out of the response, e.g. `body,summary`. <br/><br/>Cannot be used with `properties`.
+	*/
+	ExcludeProperties *string
+
 	/* ID.
 
 	   Unique ID of the Object.
@@ -101,6 +107,12 @@ type ObjectsClassGetParams struct {
 	*/
 	NodeName *string
 
+	/* Properties.
+
+	   Comma-separated list of the properties to return, e.g. `title,author`. All other properties are left out of the response. <br/><br/>Cannot be used with `exclude_properties`.
+	*/
+	Properties *string
+
 	/* Tenant.
 
 	   Specifies the tenant in a request targeting a multi-tenant class
@@ -182,6 +194,17 @@ func (o *ObjectsClassGetParams) SetConsistencyLevel(consistencyLevel *string) {
 	o.ConsistencyLevel = consistencyLevel
 }
 
+// WithExcludeProperties adds the excludeProperties to the objects class get params
+func (o *ObjectsClassGetParams) WithExcludeProperties(excludeProperties *string) *ObjectsClassGetParams {
+	o.SetExcludeProperties(excludeProperties)
+	return o
+}
+
+// SetExcludeProperties adds the excludeProperties to the objects class get params
+func (o *ObjectsClassGetParams) SetExcludeProperties(excludeProperties *string) {
+	o.ExcludeProperties = excludeProperties
+}
+
 // WithID adds the id to the objects class get params
 func (o *ObjectsClassGetParams) WithID(id strfmt.UUID) *ObjectsClassGetParams {
 	o.SetID(id)
@@ -215,6 +238,17 @@ func (o *ObjectsClassGetParams) SetNodeName(nodeName *string) {
 	o.NodeName = nodeName
 }
 
+// WithProperties adds the properties to the objects class get params
+func (o *ObjectsClassGetParams) WithProperties(properties *string) *ObjectsClassGetParams {
+	o.SetProperties(properties)
+	return o
+}
+
+// SetProperties adds the properties to the objects class get params
+func (o *ObjectsClassGetParams) SetProperties(properties *string) {
+	o.Properties = properties
+}
+
 // WithTenant adds the tenant to the objects class get params
 func (o *ObjectsClassGetParams) WithTenant(tenant *string) *ObjectsClassGetParams {
 	o.SetTenant(tenant)
@@ -256,6 +290,23 @@ func (o *ObjectsClassGetParams) WriteToRequest(r runtime.ClientRequest, reg strf
 		}
 	}
 
+	if o.ExcludeProperties != nil {
+
+		// query param exclude_properties
+		var qrExcludeProperties string
+
+		if o.ExcludeProperties != nil {
+			qrExcludeProperties = *o.ExcludeProperties
+		}
+		qExcludeProperties := qrExcludeProperties
+		if qExcludeProperties != "" {
+
+			if err := r.SetQueryParam("exclude_properties", qExcludeProperties); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param id
 	if err := r.SetPathParam("id", o.ID.String()); err != nil {
 		return err
@@ -295,6 +346,23 @@ func (o *ObjectsClassGetParams) WriteToRequest(r runtime.ClientRequest, reg strf
 		}
 	}
 
+	if o.Properties != nil {
+
+		// query param properties
+		var qrProperties string
+
+		if o.Properties != nil {
+			qrProperties = *o.Properties
+		}
+		qProperties := qrProperties
+		if qProperties != "" {
+
+			if err := r.SetQueryParam("properties", qProperties); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Tenant != nil {
 
 		// query param tenant
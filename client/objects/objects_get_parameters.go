@@ -72,6 +72,12 @@ ObjectsGetParams contains all the parameters to send to the API endpoint
 */
 type ObjectsGetParams struct {
 
+	/* ExcludeProperties.
+
+	   Comma-separated list of the properties to leave out of the response, e.g. `body,summary`. <br/><br/>Cannot be used with `properties`.
+	*/
+	ExcludeProperties *string
+
 	/* ID.
 
 	   Unique ID of the Object.
@@ -86,6 +92,12 @@ type ObjectsGetParams struct {
 	*/
 	Include *string
 
+	/* Properties.
+
+	   Comma-separated list of the properties to return, e.g. `title,author`. All other properties are left out of the response. <br/><br/>Cannot be used with `exclude_properties`.
+	*/
+	Properties *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -139,6 +151,17 @@ func (o *ObjectsGetParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithExcludeProperties adds the excludeProperties to the objects get params
+func (o *ObjectsGetParams) WithExcludeProperties(excludeProperties *string) *ObjectsGetParams {
+	o.SetExcludeProperties(excludeProperties)
+	return o
+}
+
+// SetExcludeProperties adds the excludeProperties to the objects get params
+func (o *ObjectsGetParams) SetExcludeProperties(excludeProperties *string) {
+	o.ExcludeProperties = excludeProperties
+}
+
 // WithID adds the id to the objects get params
 func (o *ObjectsGetParams) WithID(id strfmt.UUID) *ObjectsGetParams {
 	o.SetID(id)
@@ -161,6 +184,17 @@ func (o *ObjectsGetParams) SetInclude(include *string) {
 	o.Include = include
 }
 
+// WithProperties adds the properties to the objects get params
+func (o *ObjectsGetParams) WithProperties(properties *string) *ObjectsGetParams {
+	o.SetProperties(properties)
+	return o
+}
+
+// SetProperties adds the properties to the objects get params
+func (o *ObjectsGetParams) SetProperties(properties *string) {
+	o.Properties = properties
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ObjectsGetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -169,6 +203,23 @@ func (o *ObjectsGetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 	}
 	var res []error
 
+	if o.ExcludeProperties != nil {
+
+		// query param exclude_properties
+		var qrExcludeProperties string
+
+		if o.ExcludeProperties != nil {
+			qrExcludeProperties = *o.ExcludeProperties
+		}
+		qExcludeProperties := qrExcludeProperties
+		if qExcludeProperties != "" {
+
+			if err := r.SetQueryParam("exclude_properties", qExcludeProperties); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param id
 	if err := r.SetPathParam("id", o.ID.String()); err != nil {
 		return err
@@ -191,6 +242,23 @@ func (o *ObjectsGetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 		}
 	}
 
+	if o.Properties != nil {
+
+		// query param properties
+		var qrProperties string
+
+		if o.Properties != nil {
+			qrProperties = *o.Properties
+		}
+		qProperties := qrProperties
+		if qProperties != "" {
+
+			if err := r.SetQueryParam("properties", qProperties); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -85,6 +85,12 @@ type ObjectsListParams struct {
 	*/
 	Class *string
 
+	/* ExcludeProperties.
+
+	   Comma-separated list of the properties to leave out of the response, e.g. `body,summary`. <br/><br/>Cannot be used with `properties`.
+	*/
+	ExcludeProperties *string
+
 	/* Include.
 
 	   Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation
@@ -113,6 +119,12 @@ type ObjectsListParams struct {
 	*/
 	Order *string
 
+	/* Properties.
+
+	   Comma-separated list of the properties to return, e.g. `title,author`. All other properties are left out of the response. <br/><br/>Cannot be used with `exclude_properties`.
+	*/
+	Properties *string
+
 	/* Sort.
 
 	   Name(s) of the property to sort by - e.g. `city`, or `country,city`.
@@ -211,6 +223,17 @@ func (o *ObjectsListParams) SetClass(class *string) {
 	o.Class = class
 }
 
+// WithExcludeProperties adds the excludeProperties to the objects list params
+func (o *ObjectsListParams) WithExcludeProperties(excludeProperties *string) *ObjectsListParams {
+	o.SetExcludeProperties(excludeProperties)
+	return o
+}
+
+// SetExcludeProperties adds the excludeProperties to the objects list params
+func (o *ObjectsListParams) SetExcludeProperties(excludeProperties *string) {
+	o.ExcludeProperties = excludeProperties
+}
+
 // WithInclude adds the include to the objects list params
 func (o *ObjectsListParams) WithInclude(include *string) *ObjectsListParams {
 	o.SetInclude(include)
@@ -255,6 +278,17 @@ func (o *ObjectsListParams) SetOrder(order *string) {
 	o.Order = order
 }
 
+// WithProperties adds the properties to the objects list params
+func (o *ObjectsListParams) WithProperties(properties *string) *ObjectsListParams {
+	o.SetProperties(properties)
+	return o
+}
+
+// SetProperties adds the properties to the objects list params
+func (o *ObjectsListParams) SetProperties(properties *string) {
+	o.Properties = properties
+}
+
 // WithSort adds the sort to the objects list params
 func (o *ObjectsListParams) WithSort(sort *string) *ObjectsListParams {
 	o.SetSort(sort)
@@ -319,6 +353,23 @@ func (o *ObjectsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 		}
 	}
 
+	if o.ExcludeProperties != nil {
+
+		// query param exclude_properties
+		var qrExcludeProperties string
+
+		if o.ExcludeProperties != nil {
+			qrExcludeProperties = *o.ExcludeProperties
+		}
+		qExcludeProperties := qrExcludeProperties
+		if qExcludeProperties != "" {
+
+			if err := r.SetQueryParam("exclude_properties", qExcludeProperties); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Include != nil {
 
 		// query param include
@@ -387,6 +438,23 @@ func (o *ObjectsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 		}
 	}
 
+	if o.Properties != nil {
+
+		// query param properties
+		var qrProperties string
+
+		if o.Properties != nil {
+			qrProperties = *o.Properties
+		}
+		qProperties := qrProperties
+		if qProperties != "" {
+
+			if err := r.SetQueryParam("properties", qProperties); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Sort != nil {
 
 		// query param sort
@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package api
+
+import "encoding/json"
+
+// QueryTemplate is a named, parameterized query which is stored in the
+// schema and executed by name. It holds either a GraphQL query or a search
+// request of the gRPC API.
+type QueryTemplate struct {
+	Name string
+	// Version is the raft log index of the last change of the template. It's
+	// set when the change is applied, so it increases with every change and
+	// is never reused, not even after the template was deleted.
+	Version     uint64
+	Description string `json:",omitempty"`
+	// Query is a GraphQL query
+	Query string `json:",omitempty"`
+	// Search is a SearchRequest of the gRPC API in its JSON representation
+	Search json.RawMessage `json:",omitempty"`
+	// Roles which may execute the template, empty allows everyone
+	Roles []string `json:",omitempty"`
+}
+
+type UpsertQueryTemplateRequest struct {
+	Template QueryTemplate
+	// Create only adds a template which doesn't exist yet, otherwise only an
+	// existing template is updated
+	Create bool
+	// ExpectedVersion rejects the update if the template changed since it
+	// was read, zero doesn't check the version
+	ExpectedVersion uint64
+}
+
+type DeleteQueryTemplateRequest struct {
+	Name string
+}
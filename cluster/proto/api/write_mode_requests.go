@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package api
+
+import "fmt"
+
+// WriteMode controls which writes are accepted, either cluster-wide or for a
+// single collection. The stricter of both applies.
+type WriteMode string
+
+const (
+	// WriteModeReadWrite accepts all writes, it's the default
+	WriteModeReadWrite WriteMode = "READ_WRITE"
+	// WriteModeSchemaFrozen rejects schema changes, but still accepts data writes
+	WriteModeSchemaFrozen WriteMode = "SCHEMA_FROZEN"
+	// WriteModeReadOnly rejects schema changes and data writes
+	WriteModeReadOnly WriteMode = "READ_ONLY"
+)
+
+// Validate returns an error if the mode is unknown. The empty mode is
+// treated as WriteModeReadWrite.
+func (m WriteMode) Validate() error {
+	switch m {
+	case "", WriteModeReadWrite, WriteModeSchemaFrozen, WriteModeReadOnly:
+		return nil
+	default:
+		return fmt.Errorf("invalid write mode %q, must be one of %q, %q or %q",
+			m, WriteModeReadWrite, WriteModeSchemaFrozen, WriteModeReadOnly)
+	}
+}
+
+// Normalize returns WriteModeReadWrite for the empty mode
+func (m WriteMode) Normalize() WriteMode {
+	if m == "" {
+		return WriteModeReadWrite
+	}
+	return m
+}
+
+// AllowsSchemaChanges is true if classes, properties and tenants may be
+// added, updated or deleted
+func (m WriteMode) AllowsSchemaChanges() bool {
+	return m.Normalize() == WriteModeReadWrite
+}
+
+// AllowsDataWrites is true if objects and references may be written
+func (m WriteMode) AllowsDataWrites() bool {
+	return m.Normalize() != WriteModeReadOnly
+}
+
+type UpdateWriteModeRequest struct {
+	// Collection is empty for the cluster-wide write mode
+	Collection string
+	Mode       WriteMode
+}
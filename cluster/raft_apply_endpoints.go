@@ -191,6 +191,52 @@ func (s *Raft) UpdateTenantsProcess(ctx context.Context, class string, req *cmd.
 	return s.Execute(ctx, command)
 }
 
+// UpdateWriteMode sets the write mode of collection, or the cluster-wide
+// write mode if collection is empty
+func (s *Raft) UpdateWriteMode(ctx context.Context, collection string, mode cmd.WriteMode) (uint64, error) {
+	req := cmd.UpdateWriteModeRequest{Collection: collection, Mode: mode}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_UPDATE_WRITE_MODE,
+		Class:      collection,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
+// UpsertQueryTemplate creates or updates a query template, see
+// cmd.UpsertQueryTemplateRequest. The returned version is the new version of
+// the template.
+func (s *Raft) UpsertQueryTemplate(ctx context.Context, req *cmd.UpsertQueryTemplateRequest) (uint64, error) {
+	if req == nil || req.Template.Name == "" {
+		return 0, fmt.Errorf("empty query template name or nil request : %w", schema.ErrBadRequest)
+	}
+	subCommand, err := json.Marshal(req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_UPSERT_QUERY_TEMPLATE,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
+func (s *Raft) DeleteQueryTemplate(ctx context.Context, name string) (uint64, error) {
+	subCommand, err := json.Marshal(&cmd.DeleteQueryTemplateRequest{Name: name})
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_DELETE_QUERY_TEMPLATE,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
 func (s *Raft) StoreSchemaV1() error {
 	command := &cmd.ApplyRequest{
 		Type: cmd.ApplyRequest_TYPE_STORE_SCHEMA_V1,
@@ -85,6 +85,13 @@ func (s *SchemaManager) Restore(rc io.ReadCloser, parser Parser) error {
 }
 
 func (s *SchemaManager) PreApplyFilter(req *command.ApplyRequest) error {
+	if err := s.preApplyWriteModeFilter(req); err != nil {
+		return err
+	}
+	if err := s.preApplyQueryTemplateFilter(req); err != nil {
+		return err
+	}
+
 	classInfo := s.schema.ClassInfo(req.Class)
 
 	// Discard restoring a class if it already exists
@@ -35,6 +35,8 @@ type (
 		ShardVersion uint64
 		// ShardProcesses map[tenantName-action(FREEZING/UNFREEZING)]map[nodeID]TenantsProcess
 		ShardProcesses map[string]NodeShardProcess
+		// WriteMode of the collection, empty means read-write
+		WriteMode command.WriteMode `json:",omitempty"`
 	}
 )
 
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+var (
+	ErrQueryTemplateExists   = errors.New("query template already exists")
+	ErrQueryTemplateNotFound = errors.New("query template not found")
+	ErrQueryTemplateVersion  = errors.New("query template version mismatch")
+)
+
+func (s *SchemaManager) UpsertQueryTemplate(cmd *command.ApplyRequest) error {
+	req := command.UpsertQueryTemplateRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	return s.schema.upsertQueryTemplate(req, cmd.Version)
+}
+
+func (s *SchemaManager) DeleteQueryTemplate(cmd *command.ApplyRequest) error {
+	req := command.DeleteQueryTemplateRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	return s.schema.deleteQueryTemplate(req)
+}
+
+// preApplyQueryTemplateFilter rejects template changes which would fail when
+// they are applied, so that they don't end up in the log
+func (s *SchemaManager) preApplyQueryTemplateFilter(req *command.ApplyRequest) error {
+	switch req.Type {
+	case command.ApplyRequest_TYPE_UPSERT_QUERY_TEMPLATE:
+		sub := command.UpsertQueryTemplateRequest{}
+		if err := json.Unmarshal(req.SubCommand, &sub); err != nil {
+			return fmt.Errorf("%w: %w", ErrBadRequest, err)
+		}
+		s.schema.mu.RLock()
+		defer s.schema.mu.RUnlock()
+		return s.schema.checkUpsertQueryTemplate(sub)
+
+	case command.ApplyRequest_TYPE_DELETE_QUERY_TEMPLATE:
+		sub := command.DeleteQueryTemplateRequest{}
+		if err := json.Unmarshal(req.SubCommand, &sub); err != nil {
+			return fmt.Errorf("%w: %w", ErrBadRequest, err)
+		}
+		s.schema.mu.RLock()
+		defer s.schema.mu.RUnlock()
+		if _, ok := s.schema.queryTemplates[sub.Name]; !ok {
+			return fmt.Errorf("%w: %q", ErrQueryTemplateNotFound, sub.Name)
+		}
+		return nil
+
+	default:
+		return nil
+	}
+}
+
+// QueryTemplate returns the template with the given name
+func (s *schema) QueryTemplate(name string) (command.QueryTemplate, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	t, ok := s.queryTemplates[name]
+	return t, ok
+}
+
+// QueryTemplates returns all templates sorted by name
+func (s *schema) QueryTemplates() []command.QueryTemplate {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	out := make([]command.QueryTemplate, 0, len(s.queryTemplates))
+	for _, t := range s.queryTemplates {
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// checkUpsertQueryTemplate must be called with s.mu held
+func (s *schema) checkUpsertQueryTemplate(req command.UpsertQueryTemplateRequest) error {
+	name := req.Template.Name
+	if name == "" {
+		return fmt.Errorf("%w: empty query template name", ErrBadRequest)
+	}
+
+	current, ok := s.queryTemplates[name]
+	switch {
+	case req.Create && ok:
+		return fmt.Errorf("%w: %q", ErrQueryTemplateExists, name)
+	case !req.Create && !ok:
+		return fmt.Errorf("%w: %q", ErrQueryTemplateNotFound, name)
+	case req.ExpectedVersion != 0 && req.ExpectedVersion != current.Version:
+		return fmt.Errorf("%w: %q has version %d, expected %d",
+			ErrQueryTemplateVersion, name, current.Version, req.ExpectedVersion)
+	}
+	return nil
+}
+
+func (s *schema) upsertQueryTemplate(req command.UpsertQueryTemplateRequest, version uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkUpsertQueryTemplate(req); err != nil {
+		return err
+	}
+
+	t := req.Template
+	t.Version = version
+	s.queryTemplates[t.Name] = t
+	return nil
+}
+
+func (s *schema) deleteQueryTemplate(req command.DeleteQueryTemplateRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.queryTemplates[req.Name]; !ok {
+		return fmt.Errorf("%w: %q", ErrQueryTemplateNotFound, req.Name)
+	}
+	delete(s.queryTemplates, req.Name)
+	return nil
+}
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/usecases/fakes"
+)
+
+func TestQueryTemplates(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	newManager := func() *SchemaManager {
+		return NewSchemaManager("N1", fakes.NewMockSchemaExecutor(), fakes.NewMockParser(),
+			prometheus.NewPedanticRegistry(), logger)
+	}
+	upsertRequest := func(t *testing.T, req command.UpsertQueryTemplateRequest) *command.ApplyRequest {
+		sub, err := json.Marshal(req)
+		require.Nil(t, err)
+		return &command.ApplyRequest{Type: command.ApplyRequest_TYPE_UPSERT_QUERY_TEMPLATE, SubCommand: sub}
+	}
+	deleteRequest := func(t *testing.T, name string) *command.ApplyRequest {
+		sub, err := json.Marshal(command.DeleteQueryTemplateRequest{Name: name})
+		require.Nil(t, err)
+		return &command.ApplyRequest{Type: command.ApplyRequest_TYPE_DELETE_QUERY_TEMPLATE, SubCommand: sub}
+	}
+	upsert := func(t *testing.T, m *SchemaManager, req command.UpsertQueryTemplateRequest, version uint64) {
+		cmd := upsertRequest(t, req)
+		require.Nil(t, m.PreApplyFilter(cmd))
+		cmd.Version = version
+		require.Nil(t, m.UpsertQueryTemplate(cmd))
+	}
+	articles := command.QueryTemplate{Name: "articles", Query: "{ Get { Article { title } } }"}
+
+	t.Run("create", func(t *testing.T) {
+		m := newManager()
+		upsert(t, m, command.UpsertQueryTemplateRequest{Template: articles, Create: true}, 7)
+
+		got, ok := m.NewSchemaReader().QueryTemplate("articles")
+		require.True(t, ok)
+		assert.Equal(t, uint64(7), got.Version)
+		assert.Equal(t, articles.Query, got.Query)
+
+		err := m.PreApplyFilter(upsertRequest(t, command.UpsertQueryTemplateRequest{Template: articles, Create: true}))
+		assert.ErrorIs(t, err, ErrQueryTemplateExists)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		m := newManager()
+		err := m.PreApplyFilter(upsertRequest(t, command.UpsertQueryTemplateRequest{Template: articles}))
+		assert.ErrorIs(t, err, ErrQueryTemplateNotFound)
+
+		upsert(t, m, command.UpsertQueryTemplateRequest{Template: articles, Create: true}, 7)
+		updated := articles
+		updated.Description = "all articles"
+
+		err = m.PreApplyFilter(upsertRequest(t, command.UpsertQueryTemplateRequest{Template: updated, ExpectedVersion: 6}))
+		assert.ErrorIs(t, err, ErrQueryTemplateVersion)
+
+		upsert(t, m, command.UpsertQueryTemplateRequest{Template: updated, ExpectedVersion: 7}, 9)
+		upsert(t, m, command.UpsertQueryTemplateRequest{Template: updated}, 10)
+
+		got, ok := m.NewSchemaReader().QueryTemplate("articles")
+		require.True(t, ok)
+		assert.Equal(t, uint64(10), got.Version)
+		assert.Equal(t, "all articles", got.Description)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		m := newManager()
+		assert.ErrorIs(t, m.PreApplyFilter(deleteRequest(t, "articles")), ErrQueryTemplateNotFound)
+
+		upsert(t, m, command.UpsertQueryTemplateRequest{Template: articles, Create: true}, 7)
+		require.Nil(t, m.PreApplyFilter(deleteRequest(t, "articles")))
+		require.Nil(t, m.DeleteQueryTemplate(deleteRequest(t, "articles")))
+
+		_, ok := m.NewSchemaReader().QueryTemplate("articles")
+		assert.False(t, ok)
+		assert.ErrorIs(t, m.DeleteQueryTemplate(deleteRequest(t, "articles")), ErrQueryTemplateNotFound)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		m := newManager()
+		err := m.PreApplyFilter(upsertRequest(t, command.UpsertQueryTemplateRequest{Create: true}))
+		assert.ErrorIs(t, err, ErrBadRequest)
+
+		err = m.PreApplyFilter(&command.ApplyRequest{
+			Type:       command.ApplyRequest_TYPE_UPSERT_QUERY_TEMPLATE,
+			SubCommand: []byte("{"),
+		})
+		assert.ErrorIs(t, err, ErrBadRequest)
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		m := newManager()
+		search := command.QueryTemplate{
+			Name:   "search",
+			Search: json.RawMessage(`{"collection":"Article","limit":"$limit"}`),
+			Roles:  []string{"viewer"},
+		}
+		upsert(t, m, command.UpsertQueryTemplateRequest{Template: articles, Create: true}, 7)
+		upsert(t, m, command.UpsertQueryTemplateRequest{Template: search, Create: true}, 8)
+
+		sink := &MockSnapshotSink{}
+		require.Nil(t, m.schema.Persist(sink))
+
+		parser := fakes.NewMockParser()
+		parser.On("ParseClass", mock.Anything).Return(nil)
+		sc := NewSchema("N1", fakes.NewMockSchemaExecutor(), prometheus.NewPedanticRegistry())
+		upsert := command.UpsertQueryTemplateRequest{Template: command.QueryTemplate{Name: "stale"}, Create: true}
+		require.Nil(t, sc.upsertQueryTemplate(upsert, 1))
+		require.Nil(t, sc.Restore(sink, parser))
+
+		templates := sc.QueryTemplates()
+		require.Len(t, templates, 2)
+		assert.Equal(t, "articles", templates[0].Name)
+		assert.Equal(t, uint64(7), templates[0].Version)
+		assert.Equal(t, "search", templates[1].Name)
+		assert.Equal(t, uint64(8), templates[1].Version)
+		assert.JSONEq(t, string(search.Search), string(templates[1].Search))
+		assert.Equal(t, []string{"viewer"}, templates[1].Roles)
+	})
+}
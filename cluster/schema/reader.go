@@ -16,6 +16,7 @@ import (
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/prometheus/client_golang/prometheus"
+	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/types"
 	"github.com/weaviate/weaviate/cluster/utils"
 	"github.com/weaviate/weaviate/entities/models"
@@ -71,6 +72,30 @@ func (rs SchemaReader) ClassEqual(name string) string {
 	return rs.schema.ClassEqual(name)
 }
 
+// WriteModes returns the cluster-wide write mode and the write modes of all
+// collections which are not read-write
+func (rs SchemaReader) WriteModes() (command.WriteMode, map[string]command.WriteMode) {
+	return rs.schema.WriteModes()
+}
+
+// QueryTemplate returns the query template with the given name
+func (rs SchemaReader) QueryTemplate(name string) (command.QueryTemplate, bool) {
+	return rs.schema.QueryTemplate(name)
+}
+
+// QueryTemplates returns all query templates sorted by name
+func (rs SchemaReader) QueryTemplates() []command.QueryTemplate {
+	return rs.schema.QueryTemplates()
+}
+
+// CheckDataWrite returns an error if the cluster-wide or the collection's
+// write mode rejects writing objects and references
+func (rs SchemaReader) CheckDataWrite(class string) error {
+	cluster, collection := rs.schema.WriteMode(class)
+	return checkWriteMode(cluster, collection, class,
+		command.WriteMode.AllowsDataWrites, ErrReadOnly)
+}
+
 func (rs SchemaReader) MultiTenancy(class string) models.MultiTenancyConfig {
 	t := prometheus.NewTimer(monitoring.GetMetrics().SchemaReadsLocal.WithLabelValues("MultiTenancy"))
 	defer t.ObserveDuration()
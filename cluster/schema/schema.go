@@ -16,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"strings"
 	"sync"
 
@@ -56,10 +57,16 @@ type schema struct {
 	nodeID      string
 	shardReader shardReader
 
-	// mu protects the `classes`
+	// mu protects the `classes`, `writeMode` and `queryTemplates`
 	mu      sync.RWMutex
 	classes map[string]*metaClass
 
+	// writeMode is the cluster-wide write mode, empty means read-write
+	writeMode command.WriteMode
+
+	// queryTemplates by name
+	queryTemplates map[string]command.QueryTemplate
+
 	// metrics
 	// collectionsCount represents the number of collections on this specific node.
 	collectionsCount prometheus.Gauge
@@ -73,9 +80,10 @@ func NewSchema(nodeID string, shardReader shardReader, reg prometheus.Registerer
 	r := promauto.With(reg)
 
 	s := &schema{
-		nodeID:      nodeID,
-		classes:     make(map[string]*metaClass, 128),
-		shardReader: shardReader,
+		nodeID:         nodeID,
+		classes:        make(map[string]*metaClass, 128),
+		queryTemplates: make(map[string]command.QueryTemplate),
+		shardReader:    shardReader,
 		collectionsCount: r.NewGauge(prometheus.GaugeOpts{
 			Namespace:   "weaviate",
 			Name:        "schema_collections",
@@ -528,6 +536,7 @@ func (s *schema) MetaClasses() map[string]*metaClass {
 			ClassVersion: v.ClassVersion,
 			Sharding:     v.Sharding.DeepCopy(),
 			ShardVersion: v.ShardVersion,
+			WriteMode:    v.WriteMode,
 		}
 		v.RUnlock()
 	}
@@ -548,6 +557,16 @@ func (s *schema) Restore(r io.Reader, parser Parser) error {
 	}
 
 	s.replaceClasses(snap.Classes)
+
+	queryTemplates := snap.QueryTemplates
+	if queryTemplates == nil {
+		queryTemplates = make(map[string]command.QueryTemplate)
+	}
+
+	s.mu.Lock()
+	s.writeMode = snap.WriteMode
+	s.queryTemplates = queryTemplates
+	s.mu.Unlock()
 	return nil
 }
 
@@ -556,10 +575,18 @@ func (s *schema) Restore(r io.Reader, parser Parser) error {
 func (s *schema) Persist(sink raft.SnapshotSink) (err error) {
 	// we don't need to lock here because, we call MetaClasses() which is thread-safe
 	defer sink.Close()
+	s.mu.RLock()
+	writeMode := s.writeMode
+	// templates are replaced as a whole on every change, so copying the map
+	// is enough
+	queryTemplates := maps.Clone(s.queryTemplates)
+	s.mu.RUnlock()
 	snap := snapshot{
-		NodeID:     s.nodeID,
-		SnapshotID: sink.ID(),
-		Classes:    s.MetaClasses(),
+		NodeID:         s.nodeID,
+		SnapshotID:     sink.ID(),
+		Classes:        s.MetaClasses(),
+		WriteMode:      writeMode,
+		QueryTemplates: queryTemplates,
 	}
 	if err := json.NewEncoder(sink).Encode(&snap); err != nil {
 		return fmt.Errorf("encode: %w", err)
@@ -17,13 +17,16 @@ import (
 	"io"
 
 	"github.com/hashicorp/raft"
+	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/types"
 )
 
 type snapshot struct {
-	NodeID     string                `json:"node_id"`
-	SnapshotID string                `json:"snapshot_id"`
-	Classes    map[string]*metaClass `json:"classes"`
+	NodeID         string                           `json:"node_id"`
+	SnapshotID     string                           `json:"snapshot_id"`
+	Classes        map[string]*metaClass            `json:"classes"`
+	WriteMode      command.WriteMode                `json:"write_mode,omitempty"`
+	QueryTemplates map[string]command.QueryTemplate `json:"query_templates,omitempty"`
 }
 
 // LegacySnapshot returns a ready-to-use in-memory Raft snapshot based on the provided legacy schema
@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+var (
+	ErrSchemaFrozen = errors.New("schema changes are rejected")
+	ErrReadOnly     = errors.New("writes are rejected")
+)
+
+// schemaChanges are the commands rejected while the schema is frozen. Tenant
+// status updates are still accepted, so that tenants can be offloaded and
+// activated.
+var schemaChanges = map[command.ApplyRequest_Type]struct{}{
+	command.ApplyRequest_TYPE_ADD_CLASS:     {},
+	command.ApplyRequest_TYPE_UPDATE_CLASS:  {},
+	command.ApplyRequest_TYPE_DELETE_CLASS:  {},
+	command.ApplyRequest_TYPE_RESTORE_CLASS: {},
+	command.ApplyRequest_TYPE_ADD_PROPERTY:  {},
+	command.ApplyRequest_TYPE_ADD_TENANT:    {},
+	command.ApplyRequest_TYPE_DELETE_TENANT: {},
+}
+
+func (s *SchemaManager) UpdateWriteMode(cmd *command.ApplyRequest) error {
+	req := command.UpdateWriteModeRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	return s.schema.updateWriteMode(req)
+}
+
+// preApplyWriteModeFilter rejects invalid write mode updates and schema
+// changes which aren't allowed by the current write modes
+func (s *SchemaManager) preApplyWriteModeFilter(req *command.ApplyRequest) error {
+	if req.Type == command.ApplyRequest_TYPE_UPDATE_WRITE_MODE {
+		sub := command.UpdateWriteModeRequest{}
+		if err := json.Unmarshal(req.SubCommand, &sub); err != nil {
+			return fmt.Errorf("%w: %w", ErrBadRequest, err)
+		}
+		if err := sub.Mode.Validate(); err != nil {
+			return fmt.Errorf("%w: %w", ErrBadRequest, err)
+		}
+		if sub.Collection != "" && !s.schema.ClassInfo(sub.Collection).Exists {
+			return fmt.Errorf("collection %q: %w", sub.Collection, ErrClassNotFound)
+		}
+		return nil
+	}
+
+	if _, ok := schemaChanges[req.Type]; !ok {
+		return nil
+	}
+	cluster, collection := s.schema.WriteMode(req.Class)
+	return checkWriteMode(cluster, collection, req.Class,
+		command.WriteMode.AllowsSchemaChanges, ErrSchemaFrozen)
+}
+
+// WriteMode returns the cluster-wide write mode and the one of class
+func (s *schema) WriteMode(class string) (cluster, collection command.WriteMode) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	cluster = s.writeMode.Normalize()
+	collection = command.WriteModeReadWrite
+	if meta := s.classes[class]; meta != nil {
+		meta.RLock()
+		collection = meta.WriteMode.Normalize()
+		meta.RUnlock()
+	}
+	return cluster, collection
+}
+
+// WriteModes returns the cluster-wide write mode and the write modes of all
+// collections which are not read-write
+func (s *schema) WriteModes() (command.WriteMode, map[string]command.WriteMode) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	collections := map[string]command.WriteMode{}
+	for name, meta := range s.classes {
+		meta.RLock()
+		if mode := meta.WriteMode.Normalize(); mode != command.WriteModeReadWrite {
+			collections[name] = mode
+		}
+		meta.RUnlock()
+	}
+	return s.writeMode.Normalize(), collections
+}
+
+func (s *schema) updateWriteMode(req command.UpdateWriteModeRequest) error {
+	// read-write is stored as the empty mode, so that it's omitted from
+	// snapshots
+	mode := req.Mode
+	if mode == command.WriteModeReadWrite {
+		mode = ""
+	}
+
+	if req.Collection == "" {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.writeMode = mode
+		return nil
+	}
+
+	return s.updateClass(req.Collection, func(meta *metaClass) error {
+		meta.WriteMode = mode
+		return nil
+	})
+}
+
+func checkWriteMode(cluster, collection command.WriteMode, class string,
+	allowed func(command.WriteMode) bool, rejected error,
+) error {
+	if !allowed(cluster) {
+		return fmt.Errorf("%w: cluster write mode is %s", rejected, cluster.Normalize())
+	}
+	if !allowed(collection) {
+		return fmt.Errorf("%w: write mode of collection %q is %s", rejected, class, collection.Normalize())
+	}
+	return nil
+}
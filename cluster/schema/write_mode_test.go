@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/fakes"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestWriteMode(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	newManager := func(t *testing.T) *SchemaManager {
+		m := NewSchemaManager("N1", fakes.NewMockSchemaExecutor(), fakes.NewMockParser(),
+			prometheus.NewPedanticRegistry(), logger)
+		require.Nil(t, m.schema.addClass(&models.Class{Class: "C"}, &sharding.State{}, 1))
+		require.Nil(t, m.schema.addClass(&models.Class{Class: "D"}, &sharding.State{}, 2))
+		return m
+	}
+	updateRequest := func(t *testing.T, collection string, mode command.WriteMode) *command.ApplyRequest {
+		sub, err := json.Marshal(command.UpdateWriteModeRequest{Collection: collection, Mode: mode})
+		require.Nil(t, err)
+		return &command.ApplyRequest{
+			Type:       command.ApplyRequest_TYPE_UPDATE_WRITE_MODE,
+			Class:      collection,
+			SubCommand: sub,
+		}
+	}
+	update := func(t *testing.T, m *SchemaManager, collection string, mode command.WriteMode) {
+		req := updateRequest(t, collection, mode)
+		require.Nil(t, m.PreApplyFilter(req))
+		require.Nil(t, m.UpdateWriteMode(req))
+	}
+	addProperty := &command.ApplyRequest{Type: command.ApplyRequest_TYPE_ADD_PROPERTY, Class: "C"}
+	updateTenants := &command.ApplyRequest{Type: command.ApplyRequest_TYPE_UPDATE_TENANT, Class: "C"}
+
+	t.Run("read-write by default", func(t *testing.T) {
+		m := newManager(t)
+		cluster, collections := m.schema.WriteModes()
+		assert.Equal(t, command.WriteModeReadWrite, cluster)
+		assert.Empty(t, collections)
+		assert.Nil(t, m.NewSchemaReader().CheckDataWrite("C"))
+	})
+
+	t.Run("invalid updates", func(t *testing.T) {
+		m := newManager(t)
+		assert.ErrorIs(t, m.PreApplyFilter(updateRequest(t, "", "FROZEN")), ErrBadRequest)
+		assert.ErrorIs(t, m.PreApplyFilter(updateRequest(t, "Missing", command.WriteModeReadOnly)), ErrClassNotFound)
+	})
+
+	t.Run("cluster schema frozen", func(t *testing.T) {
+		m := newManager(t)
+		update(t, m, "", command.WriteModeSchemaFrozen)
+
+		err := m.PreApplyFilter(addProperty)
+		assert.ErrorIs(t, err, ErrSchemaFrozen)
+		assert.ErrorContains(t, err, "cluster write mode is SCHEMA_FROZEN")
+		assert.Nil(t, m.PreApplyFilter(updateTenants))
+		assert.Nil(t, m.NewSchemaReader().CheckDataWrite("C"))
+
+		update(t, m, "", command.WriteModeReadWrite)
+		assert.Nil(t, m.PreApplyFilter(addProperty))
+	})
+
+	t.Run("collection read-only", func(t *testing.T) {
+		m := newManager(t)
+		update(t, m, "C", command.WriteModeReadOnly)
+
+		assert.ErrorIs(t, m.PreApplyFilter(addProperty), ErrSchemaFrozen)
+		err := m.NewSchemaReader().CheckDataWrite("C")
+		assert.ErrorIs(t, err, ErrReadOnly)
+		assert.ErrorContains(t, err, `write mode of collection "C" is READ_ONLY`)
+		assert.Nil(t, m.NewSchemaReader().CheckDataWrite("D"))
+
+		cluster, collections := m.schema.WriteModes()
+		assert.Equal(t, command.WriteModeReadWrite, cluster)
+		assert.Equal(t, map[string]command.WriteMode{"C": command.WriteModeReadOnly}, collections)
+	})
+
+	t.Run("cluster read-only applies to all collections", func(t *testing.T) {
+		m := newManager(t)
+		update(t, m, "", command.WriteModeReadOnly)
+
+		assert.ErrorIs(t, m.NewSchemaReader().CheckDataWrite("C"), ErrReadOnly)
+		assert.ErrorIs(t, m.NewSchemaReader().CheckDataWrite("D"), ErrReadOnly)
+		assert.ErrorIs(t, m.NewSchemaReader().CheckDataWrite(""), ErrReadOnly)
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		m := newManager(t)
+		update(t, m, "", command.WriteModeSchemaFrozen)
+		update(t, m, "D", command.WriteModeReadOnly)
+
+		sink := &MockSnapshotSink{}
+		require.Nil(t, m.schema.Persist(sink))
+
+		parser := fakes.NewMockParser()
+		parser.On("ParseClass", mock.Anything).Return(nil)
+		sc := NewSchema("N1", fakes.NewMockSchemaExecutor(), prometheus.NewPedanticRegistry())
+		require.Nil(t, sc.Restore(sink, parser))
+
+		cluster, collections := sc.WriteModes()
+		assert.Equal(t, command.WriteModeSchemaFrozen, cluster)
+		assert.Equal(t, map[string]command.WriteMode{"D": command.WriteModeReadOnly}, collections)
+	})
+}
@@ -56,7 +56,11 @@ func New(cfg Config, svrMetrics *monitoring.GRPCServerMetrics) *Service {
 	raftAdvertisedAddress := fmt.Sprintf("%s:%d", cfg.Host, cfg.RaftPort)
 	client := rpc.NewClient(resolver.NewRpc(cfg.IsLocalHost, cfg.RPCPort), cfg.RaftRPCMessageMaxSize, cfg.SentryEnabled, cfg.Logger)
 
-	fsm := NewFSM(cfg, prometheus.DefaultRegisterer)
+	reg := cfg.MetricsRegisterer
+	if reg == nil {
+		reg = prometheus.DefaultRegisterer
+	}
+	fsm := NewFSM(cfg, reg)
 	raft := NewRaft(cfg.NodeSelector, &fsm, client)
 	replicationEngine := replication.NewShardReplicationEngine(cfg.Logger, fsm.replicationManager.GetReplicationFSM(), raft, cfg.ReplicaCopier)
 
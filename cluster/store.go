@@ -151,6 +151,10 @@ type Config struct {
 
 	// ReplicaCopier copies shard replicas between nodes
 	ReplicaCopier replicationTypes.ReplicaCopier
+
+	// MetricsRegisterer is where the metrics of the raft store are
+	// registered. Defaults to prometheus.DefaultRegisterer if nil.
+	MetricsRegisterer prometheus.Registerer
 }
 
 // Store is the implementation of RAFT on this local node. It will handle the local schema and RAFT operations (startup,
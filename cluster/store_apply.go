@@ -186,6 +186,21 @@ func (st *Store) Apply(l *raft.Log) interface{} {
 			ret.Error = st.schemaManager.UpdateTenantsProcess(&cmd, schemaOnly)
 		}
 
+	case api.ApplyRequest_TYPE_UPDATE_WRITE_MODE:
+		f = func() {
+			ret.Error = st.schemaManager.UpdateWriteMode(&cmd)
+		}
+
+	case api.ApplyRequest_TYPE_UPSERT_QUERY_TEMPLATE:
+		f = func() {
+			ret.Error = st.schemaManager.UpsertQueryTemplate(&cmd)
+		}
+
+	case api.ApplyRequest_TYPE_DELETE_QUERY_TEMPLATE:
+		f = func() {
+			ret.Error = st.schemaManager.DeleteQueryTemplate(&cmd)
+		}
+
 	case api.ApplyRequest_TYPE_STORE_SCHEMA_V1:
 		f = func() {
 			ret.Error = st.StoreSchemaV1()
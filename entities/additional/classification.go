@@ -41,6 +41,11 @@ type Properties struct {
 	// operation that isn't required.
 	NoProps bool `json:"noProps"`
 
+	// Projected is set if objects are read for a projection, such as the
+	// properties of a REST request. Only the selected properties and the
+	// requested vectors are unmarshalled then, the others aren't returned.
+	Projected bool `json:"projected"`
+
 	// ReferenceQuery is used to indicate that a search
 	// is being conducted on behalf of a referenced
 	// property. for example: this is relevant when a
@@ -29,6 +29,10 @@ func (e ErrGraphQLUser) OriginalError() error {
 	return e.err
 }
 
+func (e ErrGraphQLUser) Unwrap() error {
+	return e.err
+}
+
 func (e ErrGraphQLUser) QueryType() string {
 	return e.queryType
 }
@@ -49,10 +53,20 @@ func (e ErrRateLimit) Error() string {
 	return e.err.Error()
 }
 
+func (e ErrRateLimit) Unwrap() error {
+	return e.err
+}
+
 func NewErrRateLimit() ErrRateLimit {
 	return ErrRateLimit{errors.New("429 Too many requests")}
 }
 
+// NewErrRateLimitf is a rate limit error with the reason why the request
+// wasn't admitted
+func NewErrRateLimitf(format string, args ...interface{}) ErrRateLimit {
+	return ErrRateLimit{fmt.Errorf("429 Too many requests: "+format, args...)}
+}
+
 type ErrLockConnector struct {
 	err error
 }
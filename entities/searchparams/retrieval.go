@@ -13,6 +13,7 @@ package searchparams
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/weaviate/weaviate/entities/search"
@@ -34,6 +35,75 @@ type KeywordRanking struct {
 	Properties             []string `json:"properties"`
 	Query                  string   `json:"query"`
 	AdditionalExplanations bool     `json:"additionalExplanations"`
+	// Stopwords overrides the stopword config of the class for this query
+	// only, e.g. the "none" preset keeps all stopwords
+	Stopwords *models.StopwordConfig `json:"stopwords,omitempty"`
+	// SearchOperator controls how many of the query terms a match has to
+	// contain
+	SearchOperator KeywordOperator `json:"searchOperator"`
+}
+
+const (
+	KeywordOperatorOr  = "or"
+	KeywordOperatorAnd = "and"
+)
+
+// KeywordOperator controls how many of the query terms of a keyword search a
+// match has to contain. The zero value matches any of them.
+type KeywordOperator struct {
+	// Operator is either KeywordOperatorOr (the default) or KeywordOperatorAnd,
+	// which requires all terms to match
+	Operator string `json:"operator,omitempty"`
+	// MinimumShouldMatch is the number ("2") or percentage ("75%") of query
+	// terms which have to match with KeywordOperatorOr. Percentages are
+	// rounded down.
+	MinimumShouldMatch string `json:"minimumShouldMatch,omitempty"`
+}
+
+// Validate checks the operator and the format of MinimumShouldMatch
+func (o KeywordOperator) Validate() error {
+	switch o.Operator {
+	case "", KeywordOperatorOr:
+		_, _, err := o.parseMinimumShouldMatch()
+		return err
+	case KeywordOperatorAnd:
+		if o.MinimumShouldMatch != "" {
+			return fmt.Errorf("minimumShouldMatch can only be set with operator %q", KeywordOperatorOr)
+		}
+		return nil
+	default:
+		return fmt.Errorf("operator %q is not supported, must be %q or %q",
+			o.Operator, KeywordOperatorOr, KeywordOperatorAnd)
+	}
+}
+
+// RequiredTerms returns how many of numTerms distinct query terms a match
+// has to contain, at least one and at most all of them
+func (o KeywordOperator) RequiredTerms(numTerms int) int {
+	required := 1
+	if o.Operator == KeywordOperatorAnd {
+		required = numTerms
+	} else if value, isPercentage, err := o.parseMinimumShouldMatch(); err == nil {
+		if isPercentage {
+			required = numTerms * value / 100
+		} else if value > 0 {
+			required = value
+		}
+	}
+	return max(1, min(required, numTerms))
+}
+
+func (o KeywordOperator) parseMinimumShouldMatch() (value int, isPercentage bool, err error) {
+	if o.MinimumShouldMatch == "" {
+		return 0, false, nil
+	}
+	raw, isPercentage := strings.CutSuffix(o.MinimumShouldMatch, "%")
+	value, err = strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil || value < 0 || (isPercentage && value > 100) {
+		return 0, false, fmt.Errorf("minimumShouldMatch %q must be a non-negative number or a percentage",
+			o.MinimumShouldMatch)
+	}
+	return value, isPercentage, nil
 }
 
 // Indicates whether property should be indexed
@@ -110,6 +180,10 @@ type HybridSearch struct {
 	WithDistance     bool          `json:"withDistance"`
 	NearTextParams   *NearTextParams
 	NearVectorParams *NearVector
+	// Stopwords and SearchOperator are passed on to the keyword search, see
+	// KeywordRanking
+	Stopwords      *models.StopwordConfig `json:"stopwords,omitempty"`
+	SearchOperator KeywordOperator        `json:"searchOperator"`
 }
 
 type NearObject struct {
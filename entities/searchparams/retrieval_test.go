@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package searchparams
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeywordOperator(t *testing.T) {
+	tests := []struct {
+		name     string
+		operator KeywordOperator
+		numTerms int
+		required int
+		wantErr  string
+	}{
+		{name: "default", numTerms: 4, required: 1},
+		{name: "or", operator: KeywordOperator{Operator: KeywordOperatorOr}, numTerms: 4, required: 1},
+		{name: "and", operator: KeywordOperator{Operator: KeywordOperatorAnd}, numTerms: 4, required: 4},
+		{name: "count", operator: KeywordOperator{MinimumShouldMatch: "2"}, numTerms: 4, required: 2},
+		{name: "count above terms", operator: KeywordOperator{MinimumShouldMatch: "7"}, numTerms: 4, required: 4},
+		{name: "zero count", operator: KeywordOperator{MinimumShouldMatch: "0"}, numTerms: 4, required: 1},
+		{name: "percentage rounded down", operator: KeywordOperator{MinimumShouldMatch: "75%"}, numTerms: 3, required: 2},
+		{name: "small percentage", operator: KeywordOperator{MinimumShouldMatch: "10%"}, numTerms: 3, required: 1},
+		{name: "full percentage", operator: KeywordOperator{Operator: KeywordOperatorOr, MinimumShouldMatch: "100%"}, numTerms: 3, required: 3},
+		{
+			name:     "unknown operator",
+			operator: KeywordOperator{Operator: "xor"},
+			wantErr:  `operator "xor" is not supported`,
+		},
+		{
+			name:     "minimum should match with and",
+			operator: KeywordOperator{Operator: KeywordOperatorAnd, MinimumShouldMatch: "2"},
+			wantErr:  `minimumShouldMatch can only be set with operator "or"`,
+		},
+		{
+			name:     "invalid number",
+			operator: KeywordOperator{MinimumShouldMatch: "two"},
+			wantErr:  `minimumShouldMatch "two" must be`,
+		},
+		{
+			name:     "percentage above 100",
+			operator: KeywordOperator{MinimumShouldMatch: "150%"},
+			wantErr:  `minimumShouldMatch "150%" must be`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.operator.Validate()
+			if tt.wantErr != "" {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.wantErr)
+				}
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.required, tt.operator.RequiredTerms(tt.numTerms))
+		})
+	}
+}
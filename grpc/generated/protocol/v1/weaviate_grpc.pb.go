@@ -24,6 +24,7 @@ type WeaviateClient interface {
 	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error)
 	TenantsGet(ctx context.Context, in *TenantsGetRequest, opts ...grpc.CallOption) (*TenantsGetReply, error)
 	Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateReply, error)
+	ExecuteTemplate(ctx context.Context, in *ExecuteTemplateRequest, opts ...grpc.CallOption) (*SearchReply, error)
 }
 
 type weaviateClient struct {
@@ -79,6 +80,15 @@ func (c *weaviateClient) Aggregate(ctx context.Context, in *AggregateRequest, op
 	return out, nil
 }
 
+func (c *weaviateClient) ExecuteTemplate(ctx context.Context, in *ExecuteTemplateRequest, opts ...grpc.CallOption) (*SearchReply, error) {
+	out := new(SearchReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ExecuteTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility
@@ -88,6 +98,7 @@ type WeaviateServer interface {
 	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error)
 	TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error)
 	Aggregate(context.Context, *AggregateRequest) (*AggregateReply, error)
+	ExecuteTemplate(context.Context, *ExecuteTemplateRequest) (*SearchReply, error)
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -110,6 +121,9 @@ func (UnimplementedWeaviateServer) TenantsGet(context.Context, *TenantsGetReques
 func (UnimplementedWeaviateServer) Aggregate(context.Context, *AggregateRequest) (*AggregateReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Aggregate not implemented")
 }
+func (UnimplementedWeaviateServer) ExecuteTemplate(context.Context, *ExecuteTemplateRequest) (*SearchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteTemplate not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 
 // UnsafeWeaviateServer may be embedded to opt out of forward compatibility for this service.
@@ -213,6 +227,24 @@ func _Weaviate_Aggregate_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_ExecuteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ExecuteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ExecuteTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ExecuteTemplate(ctx, req.(*ExecuteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -240,6 +272,10 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Aggregate",
 			Handler:    _Weaviate_Aggregate_Handler,
 		},
+		{
+			MethodName: "ExecuteTemplate",
+			Handler:    _Weaviate_ExecuteTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/weaviate.proto",
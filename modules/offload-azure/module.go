@@ -0,0 +1,287 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package modsloadazure offloads frozen tenants to Azure Blob Storage.
+//
+// Just like the backup-azure module it authenticates with
+// AZURE_STORAGE_CONNECTION_STRING or AZURE_STORAGE_ACCOUNT and
+// AZURE_STORAGE_KEY. If only the account is set, the default Azure credential
+// chain is used, which covers AKS Workload Identity (AZURE_CLIENT_ID,
+// AZURE_TENANT_ID and AZURE_FEDERATED_TOKEN_FILE injected by the webhook) as
+// well as managed identities.
+package modsloadazure
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	entcfg "github.com/weaviate/weaviate/entities/config"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/offload"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+const (
+	Name                     = "offload-azure"
+	azureContainer           = "OFFLOAD_AZURE_CONTAINER"
+	azureContainerAutoCreate = "OFFLOAD_AZURE_CONTAINER_AUTO_CREATE"
+	concurrency              = "OFFLOAD_AZURE_CONCURRENCY"
+	timeout                  = "OFFLOAD_TIMEOUT"
+)
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(&Module{})
+	_ = modulecapabilities.OffloadCloud(&Module{})
+)
+
+type Module struct {
+	offload.Config
+	Container       string
+	ContainerExists atomic.Bool
+	client          *azblob.Client
+	offloader       *offload.Offloader
+	logger          logrus.FieldLogger
+
+	metrics *monitoring.TenantOffloadMetrics
+}
+
+func New() *Module {
+	return &Module{
+		Config: offload.Config{
+			Concurrency: 25,
+			DataPath:    config.DefaultPersistenceDataPath,
+			Timeout:     120 * time.Second,
+		},
+		Container: "weaviate-offload",
+		metrics: monitoring.NewTenantOffloadMetrics(monitoring.Config{
+			MetricsNamespace: "weaviate",
+		}, prometheus.DefaultRegisterer),
+	}
+}
+
+func (m *Module) Name() string {
+	return Name
+}
+
+func (m *Module) Type() modulecapabilities.ModuleType {
+	return modulecapabilities.Offload
+}
+
+func (m *Module) Init(ctx context.Context,
+	params moduletools.ModuleInitParams,
+) error {
+	m.logger = params.GetLogger()
+
+	if err := m.initConfig(); err != nil {
+		return err
+	}
+
+	client, err := newClient()
+	if err != nil {
+		return fmt.Errorf("init offload azure client: %w", err)
+	}
+	m.client = client
+	m.offloader = offload.New(m.Config, containerClient{client: client, container: m.Container}, m.logger, m.metrics)
+
+	if entcfg.Enabled(os.Getenv(azureContainerAutoCreate)) {
+		if err := m.create(ctx); err != nil {
+			return fmt.Errorf("can't create offload container: %s %w", m.Container, err)
+		}
+	}
+
+	m.logger.WithFields(logrus.Fields{
+		concurrency:             m.Concurrency,
+		timeout:                 m.Timeout,
+		azureContainer:          m.Container,
+		"PERSISTENCE_DATA_PATH": m.DataPath,
+	}).Info("offload module loaded")
+	return nil
+}
+
+// initConfig reads the configuration of the module from the environment
+func (m *Module) initConfig() error {
+	if err := m.Config.FromEnv(concurrency); err != nil {
+		return err
+	}
+
+	if container := os.Getenv(azureContainer); container != "" {
+		m.Container = container
+	}
+
+	return nil
+}
+
+func newClient() (*azblob.Client, error) {
+	options := &azblob.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Retry: policy.RetryOptions{
+				MaxRetries:    3,
+				RetryDelay:    4 * time.Second,
+				MaxRetryDelay: 120 * time.Second,
+			},
+		},
+	}
+
+	if connectionString := os.Getenv("AZURE_STORAGE_CONNECTION_STRING"); connectionString != "" {
+		client, err := azblob.NewClientFromConnectionString(connectionString, options)
+		if err != nil {
+			return nil, fmt.Errorf("create client using connection string: %w", err)
+		}
+		return client, nil
+	}
+
+	accountName := os.Getenv("AZURE_STORAGE_ACCOUNT")
+	if accountName == "" {
+		return nil, fmt.Errorf("AZURE_STORAGE_ACCOUNT must be set")
+	}
+	// The service URL for blob endpoints is usually in the form: http(s)://<account>.blob.core.windows.net/
+	serviceURL := fmt.Sprintf("https://%s.blob.core.windows.net/", accountName)
+
+	if accountKey := os.Getenv("AZURE_STORAGE_KEY"); accountKey != "" {
+		cred, err := azblob.NewSharedKeyCredential(accountName, accountKey)
+		if err != nil {
+			return nil, err
+		}
+		return azblob.NewClientWithSharedKeyCredential(serviceURL, cred, options)
+	}
+
+	cred, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return nil, fmt.Errorf("create default azure credential: %w", err)
+	}
+	return azblob.NewClient(serviceURL, cred, options)
+}
+
+func (m *Module) RootHandler() http.Handler {
+	return nil
+}
+
+func (m *Module) VerifyBucket(ctx context.Context) error {
+	if m.ContainerExists.Load() {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, m.Timeout)
+	defer cancel()
+	_, err := m.client.ServiceClient().NewContainerClient(m.Container).GetProperties(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("offload container %s: %w", m.Container, err)
+	}
+	m.ContainerExists.Store(true)
+	return nil
+}
+
+func (m *Module) create(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, m.Timeout)
+	defer cancel()
+
+	_, err := m.client.CreateContainer(ctx, m.Container, nil)
+	if err != nil && !bloberror.HasCode(err, bloberror.ContainerAlreadyExists) {
+		return err
+	}
+	return nil
+}
+
+// Upload uploads the content of a shard assigned to specific node to
+// cloud provider (S3, Azure Blob storage, Google cloud storage)
+// {cloud_provider}://{configured_bucket}/{className}/{shardName}/{nodeName}/{shard content}
+func (m *Module) Upload(ctx context.Context, className, shardName, nodeName string) error {
+	return m.offloader.Upload(ctx, className, shardName, nodeName)
+}
+
+// Download downloads the content of a shard to desired node from
+// cloud provider (S3, Azure Blob storage, Google cloud storage)
+// {dataPath}/{className}/{shardName}/{content}
+func (m *Module) Download(ctx context.Context, className, shardName, nodeName string) error {
+	return m.offloader.Download(ctx, className, shardName, nodeName)
+}
+
+func (m *Module) DownloadToPath(ctx context.Context, className, shardName, nodeName, localPath string) error {
+	return m.offloader.DownloadToPath(ctx, className, shardName, nodeName, localPath)
+}
+
+// Delete deletes content of a shard assigned to specific node in
+// cloud provider (S3, Azure Blob storage, Google cloud storage)
+// Careful: if shardName and nodeName is passed empty it will delete all class frozen shards in cloud storage
+// {cloud_provider}://{configured_bucket}/{className}/{shardName}/{nodeName}/{shard content}
+func (m *Module) Delete(ctx context.Context, className, shardName, nodeName string) error {
+	return m.offloader.Delete(ctx, className, shardName, nodeName)
+}
+
+// containerClient implements offload.Client for an Azure Blob container
+type containerClient struct {
+	client    *azblob.Client
+	container string
+}
+
+func (c containerClient) Upload(ctx context.Context, blobName string, r io.Reader) error {
+	_, err := c.client.UploadStream(ctx, c.container, blobName, r, nil)
+	return err
+}
+
+func (c containerClient) Download(ctx context.Context, blobName string) (io.ReadCloser, error) {
+	resp, err := c.client.DownloadStream(ctx, c.container, blobName, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (c containerClient) Delete(ctx context.Context, blobName string) error {
+	_, err := c.client.DeleteBlob(ctx, c.container, blobName, nil)
+	if err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (c containerClient) List(ctx context.Context, prefix string, fn func(blobName string)) error {
+	pager := c.client.NewListBlobsFlatPager(c.container, &azblob.ListBlobsFlatOptions{Prefix: &prefix})
+	return listBlobs(ctx, pager, fn)
+}
+
+// blobPager is implemented by the pager of azblob.Client.NewListBlobsFlatPager
+type blobPager interface {
+	More() bool
+	NextPage(ctx context.Context) (azblob.ListBlobsFlatResponse, error)
+}
+
+func listBlobs(ctx context.Context, pager blobPager, fn func(blobName string)) error {
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range page.Segment.BlobItems {
+			if item.Name == nil {
+				continue
+			}
+			fn(*item.Name)
+		}
+	}
+	return nil
+}
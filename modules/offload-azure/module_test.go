@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modsloadazure
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/usecases/modulecomponents/offload"
+)
+
+// fakePager returns one page per entry of pages and then err, if set
+type fakePager struct {
+	pages [][]string
+	err   error
+}
+
+func (p *fakePager) More() bool {
+	return len(p.pages) > 0 || p.err != nil
+}
+
+func (p *fakePager) NextPage(ctx context.Context) (azblob.ListBlobsFlatResponse, error) {
+	var resp azblob.ListBlobsFlatResponse
+	if len(p.pages) == 0 {
+		err := p.err
+		p.err = nil
+		return resp, err
+	}
+
+	items := make([]*container.BlobItem, 0, len(p.pages[0])+1)
+	for _, name := range p.pages[0] {
+		name := name
+		items = append(items, &container.BlobItem{Name: &name})
+	}
+	// blobs without a name are skipped
+	items = append(items, &container.BlobItem{})
+	p.pages = p.pages[1:]
+	resp.Segment = &container.BlobFlatListSegment{BlobItems: items}
+	return resp, nil
+}
+
+func TestListBlobs(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("lists all blobs of all pages", func(t *testing.T) {
+		var listed []string
+		pager := &fakePager{pages: [][]string{{"c/s/n/a", "c/s/n/b"}, {"c/s/n/c"}}}
+		err := listBlobs(ctx, pager, func(blobName string) {
+			listed = append(listed, blobName)
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"c/s/n/a", "c/s/n/b", "c/s/n/c"}, listed)
+	})
+
+	t.Run("returns error of listing", func(t *testing.T) {
+		listErr := errors.New("list failed")
+		var listed []string
+		pager := &fakePager{pages: [][]string{{"c/s/n/a"}}, err: listErr}
+		err := listBlobs(ctx, pager, func(blobName string) {
+			listed = append(listed, blobName)
+		})
+		assert.ErrorIs(t, err, listErr)
+		assert.Equal(t, []string{"c/s/n/a"}, listed)
+	})
+}
+
+func TestInitConfig(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		for _, env := range []string{"PERSISTENCE_DATA_PATH", azureContainer, timeout, concurrency} {
+			t.Setenv(env, "")
+		}
+		m := &Module{
+			Config:    offload.Config{Concurrency: 25, DataPath: "./data", Timeout: 120 * time.Second},
+			Container: "weaviate-offload",
+		}
+		require.Nil(t, m.initConfig())
+		assert.Equal(t, "weaviate-offload", m.Container)
+		assert.Equal(t, 25, m.Concurrency)
+		assert.Equal(t, "./data", m.DataPath)
+		assert.Equal(t, 120*time.Second, m.Timeout)
+	})
+
+	t.Run("from environment", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_DATA_PATH", "/var/lib/weaviate")
+		t.Setenv(azureContainer, "frozen")
+		t.Setenv(timeout, "30")
+		t.Setenv(concurrency, "4")
+		m := &Module{}
+		require.Nil(t, m.initConfig())
+		assert.Equal(t, "frozen", m.Container)
+		assert.Equal(t, 4, m.Concurrency)
+		assert.Equal(t, "/var/lib/weaviate", m.DataPath)
+		assert.Equal(t, 30*time.Second, m.Timeout)
+	})
+
+	t.Run("invalid concurrency", func(t *testing.T) {
+		t.Setenv(concurrency, "many")
+		assert.NotNil(t, (&Module{}).initConfig())
+	})
+
+	t.Run("invalid timeout", func(t *testing.T) {
+		t.Setenv(timeout, "soon")
+		assert.NotNil(t, (&Module{}).initConfig())
+	})
+}
+
+func TestNewClient(t *testing.T) {
+	t.Run("requires an account", func(t *testing.T) {
+		t.Setenv("AZURE_STORAGE_CONNECTION_STRING", "")
+		t.Setenv("AZURE_STORAGE_ACCOUNT", "")
+		_, err := newClient()
+		assert.ErrorContains(t, err, "AZURE_STORAGE_ACCOUNT must be set")
+	})
+
+	t.Run("shared key", func(t *testing.T) {
+		t.Setenv("AZURE_STORAGE_CONNECTION_STRING", "")
+		t.Setenv("AZURE_STORAGE_ACCOUNT", "account")
+		t.Setenv("AZURE_STORAGE_KEY", "a2V5")
+		client, err := newClient()
+		require.Nil(t, err)
+		assert.Equal(t, "https://account.blob.core.windows.net/", client.URL())
+	})
+}
@@ -0,0 +1,279 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package modsloadgcs offloads frozen tenants to Google Cloud Storage.
+//
+// Credentials are resolved through Application Default Credentials, the same
+// way as for the backup-gcs module. On GKE this picks up Workload Identity
+// from the metadata server, elsewhere a workload identity federation
+// configuration can be provided through GOOGLE_APPLICATION_CREDENTIALS.
+package modsloadgcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/googleapis/gax-go/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+
+	entcfg "github.com/weaviate/weaviate/entities/config"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/offload"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+const (
+	Name                = "offload-gcs"
+	gcsBucket           = "OFFLOAD_GCS_BUCKET"
+	gcsBucketAutoCreate = "OFFLOAD_GCS_BUCKET_AUTO_CREATE"
+	gcsUseAuth          = "OFFLOAD_GCS_USE_AUTH"
+	concurrency         = "OFFLOAD_GCS_CONCURRENCY"
+	timeout             = "OFFLOAD_TIMEOUT"
+)
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(&Module{})
+	_ = modulecapabilities.OffloadCloud(&Module{})
+)
+
+type Module struct {
+	offload.Config
+	Bucket       string
+	BucketExists atomic.Bool
+	ProjectID    string
+	client       *storage.Client
+	offloader    *offload.Offloader
+	logger       logrus.FieldLogger
+
+	metrics *monitoring.TenantOffloadMetrics
+}
+
+func New() *Module {
+	return &Module{
+		Config: offload.Config{
+			Concurrency: 25,
+			DataPath:    config.DefaultPersistenceDataPath,
+			Timeout:     120 * time.Second,
+		},
+		Bucket: "weaviate-offload",
+		metrics: monitoring.NewTenantOffloadMetrics(monitoring.Config{
+			MetricsNamespace: "weaviate",
+		}, prometheus.DefaultRegisterer),
+	}
+}
+
+func (m *Module) Name() string {
+	return Name
+}
+
+func (m *Module) Type() modulecapabilities.ModuleType {
+	return modulecapabilities.Offload
+}
+
+func (m *Module) Init(ctx context.Context,
+	params moduletools.ModuleInitParams,
+) error {
+	m.logger = params.GetLogger()
+
+	if err := m.initConfig(); err != nil {
+		return err
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return fmt.Errorf("init offload gcs client: %w", err)
+	}
+	m.client = client
+	m.offloader = offload.New(m.Config, bucketClient{bucket: client.Bucket(m.Bucket)}, m.logger, m.metrics)
+
+	if entcfg.Enabled(os.Getenv(gcsBucketAutoCreate)) {
+		if err := m.create(ctx); err != nil {
+			return fmt.Errorf("can't create offload bucket: %s %w", m.Bucket, err)
+		}
+	}
+
+	m.logger.WithFields(logrus.Fields{
+		concurrency:             m.Concurrency,
+		timeout:                 m.Timeout,
+		gcsBucket:               m.Bucket,
+		"PERSISTENCE_DATA_PATH": m.DataPath,
+	}).Info("offload module loaded")
+	return nil
+}
+
+// initConfig reads the configuration of the module from the environment
+func (m *Module) initConfig() error {
+	if err := m.Config.FromEnv(concurrency); err != nil {
+		return err
+	}
+
+	if bucket := os.Getenv(gcsBucket); bucket != "" {
+		m.Bucket = bucket
+	}
+
+	m.ProjectID = os.Getenv("GOOGLE_CLOUD_PROJECT")
+	if m.ProjectID == "" {
+		m.ProjectID = os.Getenv("GCLOUD_PROJECT")
+		if m.ProjectID == "" {
+			m.ProjectID = os.Getenv("GCP_PROJECT")
+		}
+	}
+
+	return nil
+}
+
+func newClient(ctx context.Context) (*storage.Client, error) {
+	options := []option.ClientOption{}
+	if strings.ToLower(os.Getenv(gcsUseAuth)) != "false" {
+		creds, err := google.FindDefaultCredentials(ctx,
+			"https://www.googleapis.com/auth/devstorage.read_write")
+		if err != nil {
+			return nil, fmt.Errorf("find default credentials: %w", err)
+		}
+		options = append(options, option.WithCredentials(creds))
+	} else {
+		options = append(options, option.WithoutAuthentication())
+	}
+
+	client, err := storage.NewClient(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("create client: %w", err)
+	}
+
+	client.SetRetry(storage.WithBackoff(gax.Backoff{
+		Initial:    2 * time.Second, // Note: the client uses a jitter internally
+		Max:        60 * time.Second,
+		Multiplier: 3,
+	}),
+		storage.WithPolicy(storage.RetryAlways),
+	)
+	return client, nil
+}
+
+func (m *Module) RootHandler() http.Handler {
+	return nil
+}
+
+func (m *Module) VerifyBucket(ctx context.Context) error {
+	if m.BucketExists.Load() {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, m.Timeout)
+	defer cancel()
+	if _, err := m.client.Bucket(m.Bucket).Attrs(ctx); err != nil {
+		return fmt.Errorf("offload bucket %s: %w", m.Bucket, err)
+	}
+	m.BucketExists.Store(true)
+	return nil
+}
+
+func (m *Module) create(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, m.Timeout)
+	defer cancel()
+
+	bucket := m.client.Bucket(m.Bucket)
+	if _, err := bucket.Attrs(ctx); err == nil {
+		return nil
+	} else if !errors.Is(err, storage.ErrBucketNotExist) {
+		return err
+	}
+	return bucket.Create(ctx, m.ProjectID, nil)
+}
+
+// Upload uploads the content of a shard assigned to specific node to
+// cloud provider (S3, Azure Blob storage, Google cloud storage)
+// {cloud_provider}://{configured_bucket}/{className}/{shardName}/{nodeName}/{shard content}
+func (m *Module) Upload(ctx context.Context, className, shardName, nodeName string) error {
+	return m.offloader.Upload(ctx, className, shardName, nodeName)
+}
+
+// Download downloads the content of a shard to desired node from
+// cloud provider (S3, Azure Blob storage, Google cloud storage)
+// {dataPath}/{className}/{shardName}/{content}
+func (m *Module) Download(ctx context.Context, className, shardName, nodeName string) error {
+	return m.offloader.Download(ctx, className, shardName, nodeName)
+}
+
+func (m *Module) DownloadToPath(ctx context.Context, className, shardName, nodeName, localPath string) error {
+	return m.offloader.DownloadToPath(ctx, className, shardName, nodeName, localPath)
+}
+
+// Delete deletes content of a shard assigned to specific node in
+// cloud provider (S3, Azure Blob storage, Google cloud storage)
+// Careful: if shardName and nodeName is passed empty it will delete all class frozen shards in cloud storage
+// {cloud_provider}://{configured_bucket}/{className}/{shardName}/{nodeName}/{shard content}
+func (m *Module) Delete(ctx context.Context, className, shardName, nodeName string) error {
+	return m.offloader.Delete(ctx, className, shardName, nodeName)
+}
+
+// bucketClient implements offload.Client for a GCS bucket
+type bucketClient struct {
+	bucket *storage.BucketHandle
+}
+
+func (c bucketClient) Upload(ctx context.Context, objectName string, r io.Reader) error {
+	w := c.bucket.Object(objectName).NewWriter(ctx)
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func (c bucketClient) Download(ctx context.Context, objectName string) (io.ReadCloser, error) {
+	return c.bucket.Object(objectName).NewReader(ctx)
+}
+
+func (c bucketClient) Delete(ctx context.Context, objectName string) error {
+	err := c.bucket.Object(objectName).Delete(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (c bucketClient) List(ctx context.Context, prefix string, fn func(objectName string)) error {
+	return listObjects(c.bucket.Objects(ctx, &storage.Query{Prefix: prefix}), fn)
+}
+
+// objectIterator is implemented by *storage.ObjectIterator
+type objectIterator interface {
+	Next() (*storage.ObjectAttrs, error)
+}
+
+func listObjects(it objectIterator, fn func(objectName string)) error {
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		fn(attrs.Name)
+	}
+}
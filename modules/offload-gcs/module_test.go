@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modsloadgcs
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/iterator"
+
+	"github.com/weaviate/weaviate/usecases/modulecomponents/offload"
+)
+
+// fakeIterator returns names and then err, or iterator.Done if err is nil
+type fakeIterator struct {
+	names []string
+	err   error
+}
+
+func (it *fakeIterator) Next() (*storage.ObjectAttrs, error) {
+	if len(it.names) == 0 {
+		if it.err != nil {
+			return nil, it.err
+		}
+		return nil, iterator.Done
+	}
+	name := it.names[0]
+	it.names = it.names[1:]
+	return &storage.ObjectAttrs{Name: name}, nil
+}
+
+func TestListObjects(t *testing.T) {
+	t.Run("lists all objects", func(t *testing.T) {
+		var listed []string
+		err := listObjects(&fakeIterator{names: []string{"c/s/n/a", "c/s/n/b", "c/s/n/c"}},
+			func(objectName string) {
+				listed = append(listed, objectName)
+			})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"c/s/n/a", "c/s/n/b", "c/s/n/c"}, listed)
+	})
+
+	t.Run("returns error of listing", func(t *testing.T) {
+		listErr := errors.New("list failed")
+		var listed []string
+		err := listObjects(&fakeIterator{names: []string{"c/s/n/a"}, err: listErr},
+			func(objectName string) {
+				listed = append(listed, objectName)
+			})
+		assert.ErrorIs(t, err, listErr)
+		assert.Equal(t, []string{"c/s/n/a"}, listed)
+	})
+}
+
+func TestInitConfig(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		for _, env := range []string{"PERSISTENCE_DATA_PATH", gcsBucket, timeout, concurrency} {
+			t.Setenv(env, "")
+		}
+		t.Setenv("GOOGLE_CLOUD_PROJECT", "")
+		t.Setenv("GCLOUD_PROJECT", "")
+		t.Setenv("GCP_PROJECT", "")
+		m := &Module{
+			Config: offload.Config{Concurrency: 25, DataPath: "./data", Timeout: 120 * time.Second},
+			Bucket: "weaviate-offload",
+		}
+		require.Nil(t, m.initConfig())
+		assert.Equal(t, "weaviate-offload", m.Bucket)
+		assert.Equal(t, 25, m.Concurrency)
+		assert.Equal(t, "./data", m.DataPath)
+		assert.Equal(t, 120*time.Second, m.Timeout)
+		assert.Empty(t, m.ProjectID)
+	})
+
+	t.Run("from environment", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_DATA_PATH", "/var/lib/weaviate")
+		t.Setenv(gcsBucket, "frozen")
+		t.Setenv(timeout, "30")
+		t.Setenv(concurrency, "4")
+		t.Setenv("GOOGLE_CLOUD_PROJECT", "")
+		t.Setenv("GCLOUD_PROJECT", "")
+		t.Setenv("GCP_PROJECT", "project")
+		m := &Module{}
+		require.Nil(t, m.initConfig())
+		assert.Equal(t, "frozen", m.Bucket)
+		assert.Equal(t, 4, m.Concurrency)
+		assert.Equal(t, "/var/lib/weaviate", m.DataPath)
+		assert.Equal(t, 30*time.Second, m.Timeout)
+		assert.Equal(t, "project", m.ProjectID)
+	})
+
+	t.Run("invalid concurrency", func(t *testing.T) {
+		t.Setenv(concurrency, "many")
+		assert.NotNil(t, (&Module{}).initConfig())
+	})
+
+	t.Run("invalid timeout", func(t *testing.T) {
+		t.Setenv(timeout, "soon")
+		assert.NotNil(t, (&Module{}).initConfig())
+	})
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/offload"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 )
 
@@ -278,9 +279,9 @@ func (m *Module) Upload(ctx context.Context, className, shardName, nodeName stri
 		// Update few useful metrics
 		size, _ := dirSize(localPath)
 		m.metrics.FetchedBytes.Add(float64(size))
-		status := "success"
+		status := offload.StatusSuccess
 		if err != nil {
-			status = "failed"
+			status = offload.StatusFailed
 		}
 		m.metrics.OpsDuration.WithLabelValues("upload", status).Observe(time.Since(start).Seconds())
 	}()
@@ -321,9 +322,9 @@ func (m *Module) DownloadToPath(ctx context.Context, className, shardName, nodeN
 		// Update few useful metrics
 		size, _ := dirSize(localPath)
 		m.metrics.FetchedBytes.Add(float64(size))
-		status := "success"
+		status := offload.StatusSuccess
 		if err != nil {
-			status = "failed"
+			status = offload.StatusFailed
 		}
 		m.metrics.OpsDuration.WithLabelValues("download", status).Observe(time.Since(start).Seconds())
 	}()
@@ -371,9 +372,9 @@ func (m *Module) Delete(ctx context.Context, className, shardName, nodeName stri
 	var err error
 	defer func() {
 		// Update few useful metrics
-		status := "success"
+		status := offload.StatusSuccess
 		if err != nil {
-			status = "failed"
+			status = offload.StatusFailed
 		}
 		m.metrics.OpsDuration.WithLabelValues("delete", status).Observe(time.Since(start).Seconds())
 	}()
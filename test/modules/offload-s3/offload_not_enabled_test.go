@@ -111,6 +111,6 @@ func Test_Offload_When_Not_Enabled(t *testing.T) {
 		require.NotNil(t, tenantErr.Payload)
 		require.Len(t, tenantErr.Payload.Error, 1)
 		msg := tenantErr.Payload.Error[0].Message
-		assert.Equal(t, "can't offload tenants, because offload-s3 module is not enabled", msg)
+		assert.Equal(t, "can't offload tenants, because no offload module (offload-s3, offload-gcs, offload-azure) is enabled", msg)
 	})
 }
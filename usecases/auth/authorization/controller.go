@@ -25,3 +25,9 @@ type Controller interface {
 	RemovePermissions(role string, permissions []*Policy) error
 	HasPermission(role string, permission *Policy) (bool, error)
 }
+
+// PrincipalRoles resolves the roles a principal holds the same way as its
+// permissions are checked, including the roles of its groups
+type PrincipalRoles interface {
+	GetRoleNamesForPrincipal(principal *models.Principal) ([]string, error)
+}
@@ -28,6 +28,10 @@ const (
 	// GROUP_NAME_PREFIX to prefix role to help casbin to distinguish on Enforcing
 	GROUP_NAME_PREFIX = "group" + PREFIX_SEPARATOR
 	PREFIX_SEPARATOR  = ":"
+	// ANONYMOUS_SUBJECT holds the roles of unauthenticated users. Groups are
+	// prefixed with GROUP_NAME_PREFIX and users with their user type, so
+	// neither an OIDC group nor a user can resolve to it.
+	ANONYMOUS_SUBJECT = "anonymous" + PREFIX_SEPARATOR + authorization.AnonymousGroup
 
 	// CRUD allow all actions on a resource
 	// this is internal for casbin to handle admin actions
@@ -428,6 +432,16 @@ func PrefixGroupName(name string) string {
 	return fmt.Sprintf("%s%s", GROUP_NAME_PREFIX, name)
 }
 
+// GroupSubject returns the subject roles are assigned to for the group name
+// of the API. The reserved authorization.AnonymousGroup resolves to
+// ANONYMOUS_SUBJECT instead of an OIDC group.
+func GroupSubject(name string) string {
+	if name == authorization.AnonymousGroup {
+		return ANONYMOUS_SUBJECT
+	}
+	return PrefixGroupName(name)
+}
+
 func NameHasPrefix(name string) bool {
 	return strings.Contains(name, PREFIX_SEPARATOR)
 }
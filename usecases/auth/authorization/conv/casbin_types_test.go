@@ -1107,3 +1107,12 @@ func TestValidVerb(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupSubject(t *testing.T) {
+	require.Equal(t, "group:editors", GroupSubject("editors"))
+	require.Equal(t, ANONYMOUS_SUBJECT, GroupSubject(authorization.AnonymousGroup))
+	// no OIDC group or user resolves to the anonymous subject
+	require.NotEqual(t, ANONYMOUS_SUBJECT, PrefixGroupName(ANONYMOUS_SUBJECT))
+	require.NotEqual(t, ANONYMOUS_SUBJECT, UserNameWithTypeFromId(authorization.AnonymousGroup, models.UserTypeInputOidc))
+	require.NotEqual(t, ANONYMOUS_SUBJECT, UserNameWithTypeFromId(authorization.AnonymousGroup, models.UserTypeInputDb))
+}
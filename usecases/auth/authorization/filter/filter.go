@@ -49,11 +49,18 @@ func (f *ResourceFilter[T]) Filter(
 	if len(items) == 0 {
 		return items
 	}
+
+	// the principal is nil for anonymous requests
+	username := ""
+	if principal != nil {
+		username = principal.Username
+	}
+
 	if !f.config.Enabled {
 		// here it's either you have the permissions or not so 1 check is enough
 		if err := f.authorizer.Authorize(principal, verb, resourceFn(items[0])); err != nil {
 			logger.WithFields(logrus.Fields{
-				"username":  principal.Username,
+				"username":  username,
 				"verb":      verb,
 				"resources": items,
 			}).Error(err)
@@ -77,7 +84,7 @@ func (f *ResourceFilter[T]) Filter(
 		err := f.authorizer.Authorize(principal, verb, authorization.WildcardPath(firstResource))
 		if err != nil {
 			logger.WithFields(logrus.Fields{
-				"username": principal.Username,
+				"username": username,
 				"verb":     verb,
 				"resource": authorization.WildcardPath(firstResource),
 			}).Error(err)
@@ -99,7 +106,7 @@ func (f *ResourceFilter[T]) Filter(
 	allowedList, err := f.authorizer.FilterAuthorizedResources(principal, verb, resources...)
 	if err != nil {
 		logger.WithFields(logrus.Fields{
-			"username":  principal.Username,
+			"username":  username,
 			"verb":      verb,
 			"resources": resources,
 		}).Error(err)
@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// anonymousPrincipal authorizes unauthenticated requests if anonymous access
+// is enabled. It has no permissions by default, they are granted by assigning
+// roles to the anonymous group, e.g. a role which can read a single
+// collection or tenant. Its roles are stored for conv.ANONYMOUS_SUBJECT, so
+// that an OIDC group of the same name neither gets nor shares them.
+var anonymousPrincipal = &models.Principal{
+	Username: authorization.AnonymousGroup,
+}
+
+// anonymousAllowed restricts the anonymous principal to reading collections,
+// tenants and data, no matter which roles are assigned to it. Anything else,
+// such as users, roles, backups or the cluster, always requires
+// authentication.
+func anonymousAllowed(resource, verb string) bool {
+	if verb != authorization.READ {
+		return false
+	}
+	return strings.HasPrefix(resource, authorization.SchemaDomain+"/") ||
+		strings.HasPrefix(resource, authorization.DataDomain+"/")
+}
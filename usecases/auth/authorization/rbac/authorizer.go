@@ -23,7 +23,7 @@ import (
 )
 
 func (m *manager) authorize(principal *models.Principal, verb string, skipAudit bool, resources ...string) error {
-	if principal == nil {
+	if principal == nil && !m.anonymousAccess {
 		return fmt.Errorf("rbac: %w", errors.NewUnauthenticated())
 	}
 
@@ -31,6 +31,11 @@ func (m *manager) authorize(principal *models.Principal, verb string, skipAudit
 		return fmt.Errorf("at least 1 resource is required")
 	}
 
+	anonymous := principal == nil
+	if anonymous {
+		principal = anonymousPrincipal
+	}
+
 	logger := m.logger.WithFields(logrus.Fields{
 		"action":         "authorize",
 		"user":           principal.Username,
@@ -52,6 +57,7 @@ func (m *manager) authorize(principal *models.Principal, verb string, skipAudit
 			}).WithError(err).Error("failed to enforce policy")
 			return err
 		}
+		allowed = allowed && (!anonymous || anonymousAllowed(resource, verb))
 
 		perm, err := conv.PathToPermission(verb, resource)
 		if err != nil {
@@ -95,7 +101,7 @@ func (m *manager) AuthorizeSilent(principal *models.Principal, verb string, reso
 // FilterAuthorizedResources authorize the passed resources with best effort approach, it will return
 // list of allowed resources, if none, it will return an empty slice
 func (m *manager) FilterAuthorizedResources(principal *models.Principal, verb string, resources ...string) ([]string, error) {
-	if principal == nil {
+	if principal == nil && !m.anonymousAccess {
 		return nil, errors.NewUnauthenticated()
 	}
 
@@ -103,6 +109,11 @@ func (m *manager) FilterAuthorizedResources(principal *models.Principal, verb st
 		return nil, fmt.Errorf("at least 1 resource is required")
 	}
 
+	anonymous := principal == nil
+	if anonymous {
+		principal = anonymousPrincipal
+	}
+
 	logger := m.logger.WithFields(logrus.Fields{
 		"action":         "authorize",
 		"user":           principal.Username,
@@ -123,6 +134,7 @@ func (m *manager) FilterAuthorizedResources(principal *models.Principal, verb st
 			logger.WithError(err).WithField("resource", resource).Error("failed to enforce policy")
 			return nil, err
 		}
+		allowed = allowed && (!anonymous || anonymousAllowed(resource, verb))
 
 		if allowed {
 			perm, err := conv.PathToPermission(verb, resource)
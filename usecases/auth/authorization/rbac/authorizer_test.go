@@ -492,3 +492,128 @@ func setupTestManager(t *testing.T, logger *logrus.Logger) (*manager, error) {
 
 	return New(policyPath, conf, config.Authentication{OIDC: config.OIDC{Enabled: true}}, logger)
 }
+
+func TestAuthorizeAnonymous(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	m, err := setupTestManager(t, logger)
+	require.NoError(t, err)
+	m.anonymousAccess = true
+
+	// the anonymous group may read the Docs collection and its objects, but
+	// is (wrongly) also granted to update them and to read users
+	for _, p := range []authorization.Policy{
+		{Resource: authorization.CollectionsMetadata("Docs")[0], Verb: authorization.READ, Domain: authorization.SchemaDomain},
+		{Resource: authorization.CollectionsData("Docs")[0], Verb: authorization.READ, Domain: authorization.DataDomain},
+		{Resource: authorization.CollectionsData("Docs")[0], Verb: authorization.UPDATE, Domain: authorization.DataDomain},
+		{Resource: authorization.Users("*")[0], Verb: authorization.READ, Domain: authorization.UsersDomain},
+	} {
+		_, err := m.casbin.AddNamedPolicy("p", conv.PrefixRoleName("docs-reader"), p.Resource, p.Verb, p.Domain)
+		require.NoError(t, err)
+	}
+	_, err = m.casbin.AddRoleForUser(conv.GroupSubject(authorization.AnonymousGroup), conv.PrefixRoleName("docs-reader"))
+	require.NoError(t, err)
+
+	t.Run("read granted collection", func(t *testing.T) {
+		require.NoError(t, m.Authorize(nil, authorization.READ, authorization.CollectionsMetadata("Docs")...))
+		require.NoError(t, m.Authorize(nil, authorization.READ, authorization.Objects("Docs", "", "")))
+	})
+
+	t.Run("other collection is forbidden", func(t *testing.T) {
+		err := m.Authorize(nil, authorization.READ, authorization.CollectionsMetadata("Private")...)
+		assert.ErrorAs(t, err, &authzErrors.Forbidden{})
+	})
+
+	t.Run("writes are forbidden even if granted", func(t *testing.T) {
+		err := m.Authorize(nil, authorization.UPDATE, authorization.Objects("Docs", "", ""))
+		assert.ErrorAs(t, err, &authzErrors.Forbidden{})
+	})
+
+	t.Run("users are forbidden even if granted", func(t *testing.T) {
+		err := m.Authorize(nil, authorization.READ, authorization.Users("alice")...)
+		assert.ErrorAs(t, err, &authzErrors.Forbidden{})
+	})
+
+	t.Run("filter resources", func(t *testing.T) {
+		allowed, err := m.FilterAuthorizedResources(nil, authorization.READ,
+			authorization.CollectionsMetadata("Docs", "Private")...)
+		require.NoError(t, err)
+		assert.Equal(t, authorization.CollectionsMetadata("Docs"), allowed)
+	})
+
+	t.Run("OIDC group called anonymous", func(t *testing.T) {
+		// a real OIDC group of the same name doesn't get the anonymous roles,
+		// and its own roles aren't granted to anonymous users
+		_, err := m.casbin.AddNamedPolicy("p", conv.PrefixRoleName("private-reader"),
+			authorization.CollectionsMetadata("Private")[0], authorization.READ, authorization.SchemaDomain)
+		require.NoError(t, err)
+		_, err = m.casbin.AddRoleForUser(conv.PrefixGroupName(authorization.AnonymousGroup), conv.PrefixRoleName("private-reader"))
+		require.NoError(t, err)
+
+		principal := &models.Principal{
+			Username: "eve",
+			UserType: models.UserTypeInputOidc,
+			Groups:   []string{authorization.AnonymousGroup},
+		}
+		err = m.Authorize(principal, authorization.READ, authorization.CollectionsMetadata("Docs")...)
+		assert.ErrorAs(t, err, &authzErrors.Forbidden{})
+		require.NoError(t, m.Authorize(principal, authorization.READ, authorization.CollectionsMetadata("Private")...))
+
+		err = m.Authorize(nil, authorization.READ, authorization.CollectionsMetadata("Private")...)
+		assert.ErrorAs(t, err, &authzErrors.Forbidden{})
+	})
+
+	t.Run("unauthenticated if anonymous access is disabled", func(t *testing.T) {
+		m.anonymousAccess = false
+		defer func() { m.anonymousAccess = true }()
+		err := m.Authorize(nil, authorization.READ, authorization.CollectionsMetadata("Docs")...)
+		assert.ErrorAs(t, err, &authzErrors.Unauthenticated{})
+	})
+}
+
+func TestGetRoleNamesForPrincipal(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	m, err := setupTestManager(t, logger)
+	require.NoError(t, err)
+
+	for subject, role := range map[string]string{
+		conv.UserNameWithTypeFromId("alice", models.UserTypeInputOidc): "alice-role",
+		conv.PrefixGroupName("shop"):                                   "shop-role",
+		conv.PrefixGroupName("support"):                                "alice-role",
+		conv.ANONYMOUS_SUBJECT:                                         "anonymous-role",
+	} {
+		_, err := m.casbin.AddRoleForUser(subject, conv.PrefixRoleName(role))
+		require.NoError(t, err)
+	}
+
+	t.Run("user and group roles", func(t *testing.T) {
+		roles, err := m.GetRoleNamesForPrincipal(&models.Principal{
+			Username: "alice",
+			UserType: models.UserTypeInputOidc,
+			Groups:   []string{"shop", "support"},
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"alice-role", "shop-role"}, roles)
+	})
+
+	t.Run("group roles only", func(t *testing.T) {
+		roles, err := m.GetRoleNamesForPrincipal(&models.Principal{
+			Username: "bob",
+			UserType: models.UserTypeInputOidc,
+			Groups:   []string{"shop"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"shop-role"}, roles)
+	})
+
+	t.Run("anonymous", func(t *testing.T) {
+		roles, err := m.GetRoleNamesForPrincipal(nil)
+		require.NoError(t, err)
+		assert.Empty(t, roles)
+
+		m.anonymousAccess = true
+		defer func() { m.anonymousAccess = false }()
+		roles, err = m.GetRoleNamesForPrincipal(nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"anonymous-role"}, roles)
+	})
+}
@@ -31,6 +31,9 @@ import (
 type manager struct {
 	casbin *casbin.SyncedCachedEnforcer
 	logger logrus.FieldLogger
+	// anonymousAccess authorizes unauthenticated requests as the anonymous
+	// principal instead of rejecting them
+	anonymousAccess bool
 }
 
 func New(rbacStoragePath string, rbac rbacconf.Config, authNconf config.Authentication, logger logrus.FieldLogger) (*manager, error) {
@@ -39,7 +42,7 @@ func New(rbacStoragePath string, rbac rbacconf.Config, authNconf config.Authenti
 		return nil, err
 	}
 
-	return &manager{csbin, logger}, nil
+	return &manager{casbin: csbin, logger: logger, anonymousAccess: authNconf.AnonymousAccess.Enabled}, nil
 }
 
 // there is no different between UpdateRolesPermissions and CreateRolesPermissions, purely to satisfy an interface
@@ -213,6 +216,40 @@ func (m *manager) GetRolesForUser(userName string, userType models.UserTypeInput
 	return roles, err
 }
 
+// GetRoleNamesForPrincipal returns the names of the roles the principal holds
+// itself or through its groups, resolved the same way as its permissions are
+// checked. Without a principal these are the roles of the anonymous subject
+// if anonymous access is enabled, otherwise there are none.
+func (m *manager) GetRoleNamesForPrincipal(principal *models.Principal) ([]string, error) {
+	var subjects []string
+	switch {
+	case principal == nil && !m.anonymousAccess:
+		return nil, nil
+	case principal == nil || principal == anonymousPrincipal:
+		subjects = []string{conv.ANONYMOUS_SUBJECT}
+	default:
+		for _, group := range principal.Groups {
+			subjects = append(subjects, conv.PrefixGroupName(group))
+		}
+		subjects = append(subjects, conv.UserNameWithTypeFromPrincipal(principal))
+	}
+
+	var names []string
+	for _, subject := range subjects {
+		rolesNames, err := m.casbin.GetRolesForUser(subject)
+		if err != nil {
+			return nil, fmt.Errorf("GetRolesForUser: %w", err)
+		}
+		for _, roleName := range rolesNames {
+			name := conv.TrimRoleNamePrefix(roleName)
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	return names, nil
+}
+
 func (m *manager) GetUsersForRole(roleName string, userType models.UserTypeInput) ([]string, error) {
 	pusers, err := m.casbin.GetUsersForRole(conv.PrefixRoleName(roleName))
 	if err != nil {
@@ -256,6 +293,10 @@ func (m *manager) RevokeRolesForUser(userName string, roles ...string) error {
 // source code https://github.com/casbin/casbin/blob/master/enforcer.go#L872
 // issue https://github.com/casbin/casbin/issues/710
 func (m *manager) checkPermissions(principal *models.Principal, resource, verb string) (bool, error) {
+	if principal == anonymousPrincipal {
+		return m.casbin.Enforce(conv.ANONYMOUS_SUBJECT, resource, verb)
+	}
+
 	// first check group permissions
 	for _, group := range principal.Groups {
 		allowed, err := m.casbin.Enforce(conv.PrefixGroupName(group), resource, verb)
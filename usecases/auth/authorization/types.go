@@ -159,6 +159,12 @@ var (
 	}
 )
 
+// AnonymousGroup is the group of unauthenticated users if anonymous access is
+// enabled together with RBAC. Roles assigned to it are granted to everyone.
+// The name is reserved, roles can't be assigned to an OIDC group called
+// "anonymous".
+const AnonymousGroup = "anonymous"
+
 var (
 	Viewer       = "viewer"
 	Admin        = "admin"
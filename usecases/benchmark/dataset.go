@@ -0,0 +1,272 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package benchmark
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	DistributionUniform = "uniform"
+	DistributionZipf    = "zipf"
+	DistributionNormal  = "normal"
+
+	DefaultCardinality = 100
+	DefaultBatchSize   = 1000
+
+	// upper bounds of a dataset, a larger one is better imported with a
+	// regular client
+	MaxObjects     = 1_000_000
+	MaxDimensions  = 4096
+	MaxProperties  = 64
+	MaxCardinality = 1_000_000
+	MaxBatchSize   = 10_000
+)
+
+// namespace for the deterministic object ids of synthetic datasets
+var datasetNamespace = uuid.MustParse("9b3c5a0e-5d0f-4d3e-8f57-3b5a2c7e1d42")
+
+// PropertySpec describes how the values of a single synthetic property are
+// distributed
+type PropertySpec struct {
+	Name     string `json:"name"`
+	DataType string `json:"dataType"`
+	// Distribution is one of "uniform" (default), "zipf" for text and int
+	// properties, or "normal" for number properties
+	Distribution string `json:"distribution"`
+	// Cardinality is the number of distinct values of text and int
+	// properties, defaults to DefaultCardinality
+	Cardinality int `json:"cardinality"`
+}
+
+// DatasetSpec describes a synthetic collection. Generating the same spec
+// twice results in exactly the same objects and vectors.
+type DatasetSpec struct {
+	Collection string         `json:"collection"`
+	Objects    int            `json:"objects"`
+	Dimensions int            `json:"dimensions"`
+	Distance   string         `json:"distance"`
+	Properties []PropertySpec `json:"properties"`
+	Seed       int64          `json:"seed"`
+	BatchSize  int            `json:"batchSize"`
+}
+
+func (s *DatasetSpec) setDefaults() {
+	s.Collection = schema.UppercaseClassName(s.Collection)
+	if s.Distance == "" {
+		s.Distance = common.DistanceCosine
+	}
+	if s.BatchSize <= 0 {
+		s.BatchSize = DefaultBatchSize
+	}
+	for i := range s.Properties {
+		if s.Properties[i].Distribution == "" {
+			s.Properties[i].Distribution = DistributionUniform
+		}
+		if s.Properties[i].Cardinality <= 0 {
+			s.Properties[i].Cardinality = DefaultCardinality
+		}
+	}
+}
+
+func (s DatasetSpec) validate() error {
+	if _, err := schema.ValidateClassName(s.Collection); err != nil {
+		return err
+	}
+	if s.Objects <= 0 || s.Objects > MaxObjects {
+		return fmt.Errorf("objects must be between 1 and %d", MaxObjects)
+	}
+	if s.Dimensions <= 0 || s.Dimensions > MaxDimensions {
+		return fmt.Errorf("dimensions must be between 1 and %d", MaxDimensions)
+	}
+	if s.BatchSize > MaxBatchSize {
+		return fmt.Errorf("batch size must not be greater than %d", MaxBatchSize)
+	}
+	if len(s.Properties) > MaxProperties {
+		return fmt.Errorf("a dataset must not have more than %d properties", MaxProperties)
+	}
+
+	switch s.Distance {
+	case common.DistanceCosine, common.DistanceDot, common.DistanceL2Squared:
+	default:
+		return fmt.Errorf("unsupported distance %q", s.Distance)
+	}
+
+	for _, p := range s.Properties {
+		if _, err := schema.ValidatePropertyName(p.Name); err != nil {
+			return err
+		}
+		if p.Cardinality > MaxCardinality {
+			return fmt.Errorf("property %q: cardinality must not be greater than %d", p.Name, MaxCardinality)
+		}
+
+		switch p.DataType {
+		case schema.DataTypeText.String(), schema.DataTypeInt.String():
+			if p.Distribution != DistributionUniform && p.Distribution != DistributionZipf {
+				return fmt.Errorf("property %q: distribution must be %q or %q for data type %s",
+					p.Name, DistributionUniform, DistributionZipf, p.DataType)
+			}
+		case schema.DataTypeNumber.String():
+			if p.Distribution != DistributionUniform && p.Distribution != DistributionNormal {
+				return fmt.Errorf("property %q: distribution must be %q or %q for data type %s",
+					p.Name, DistributionUniform, DistributionNormal, p.DataType)
+			}
+		case schema.DataTypeBoolean.String():
+			if p.Distribution != DistributionUniform {
+				return fmt.Errorf("property %q: distribution must be %q for data type %s",
+					p.Name, DistributionUniform, p.DataType)
+			}
+		default:
+			return fmt.Errorf("property %q: unsupported data type %q", p.Name, p.DataType)
+		}
+	}
+
+	return nil
+}
+
+func (s DatasetSpec) class() *models.Class {
+	props := make([]*models.Property, len(s.Properties))
+	for i, p := range s.Properties {
+		props[i] = &models.Property{
+			Name:     p.Name,
+			DataType: []string{p.DataType},
+		}
+	}
+
+	return &models.Class{
+		Class:             s.Collection,
+		Description:       "Synthetic benchmark dataset",
+		Vectorizer:        "none",
+		VectorIndexConfig: map[string]interface{}{"distance": s.Distance},
+		Properties:        props,
+	}
+}
+
+// word returns the i-th word of the vocabulary of a text property
+func word(prop string, i int) string {
+	return fmt.Sprintf("%s%d", prop, i)
+}
+
+// dataset produces the objects of a DatasetSpec in a deterministic order
+type dataset struct {
+	spec  DatasetSpec
+	rand  *rand.Rand
+	zipfs map[string]*rand.Zipf
+	next  int
+}
+
+func newDataset(spec DatasetSpec) *dataset {
+	r := rand.New(rand.NewSource(spec.Seed))
+	zipfs := map[string]*rand.Zipf{}
+	for _, p := range spec.Properties {
+		if p.Distribution == DistributionZipf {
+			zipfs[p.Name] = rand.NewZipf(r, 1.1, 1, uint64(p.Cardinality-1))
+		}
+	}
+
+	return &dataset{spec: spec, rand: r, zipfs: zipfs}
+}
+
+func (d *dataset) hasNext() bool {
+	return d.next < d.spec.Objects
+}
+
+func (d *dataset) nextObject() *models.Object {
+	props := make(map[string]interface{}, len(d.spec.Properties))
+	for _, p := range d.spec.Properties {
+		props[p.Name] = d.value(p)
+	}
+
+	obj := &models.Object{
+		ID:         objectID(d.spec.Collection, d.next),
+		Class:      d.spec.Collection,
+		Properties: props,
+		Vector:     randomVector(d.rand, d.spec.Dimensions),
+	}
+	d.next++
+	return obj
+}
+
+func (d *dataset) value(p PropertySpec) interface{} {
+	switch p.DataType {
+	case schema.DataTypeText.String():
+		return word(p.Name, d.pick(p))
+	case schema.DataTypeInt.String():
+		return float64(d.pick(p))
+	case schema.DataTypeNumber.String():
+		if p.Distribution == DistributionNormal {
+			return d.rand.NormFloat64()
+		}
+		return d.rand.Float64()
+	default:
+		return d.rand.Intn(2) == 1
+	}
+}
+
+func (d *dataset) pick(p PropertySpec) int {
+	if z, ok := d.zipfs[p.Name]; ok {
+		return int(z.Uint64())
+	}
+	return d.rand.Intn(p.Cardinality)
+}
+
+func objectID(collection string, i int) strfmt.UUID {
+	return strfmt.UUID(uuid.NewSHA1(datasetNamespace, []byte(fmt.Sprintf("%s/%d", collection, i))).String())
+}
+
+// randomVector returns a vector with components uniformly distributed in
+// [-1, 1)
+func randomVector(r *rand.Rand, dims int) []float32 {
+	vec := make([]float32, dims)
+	for i := range vec {
+		vec[i] = r.Float32()*2 - 1
+	}
+	return vec
+}
+
+func distance(metric string, a, b []float32) float32 {
+	switch metric {
+	case common.DistanceDot:
+		var dot float32
+		for i := range a {
+			dot += a[i] * b[i]
+		}
+		return -dot
+	case common.DistanceL2Squared:
+		var sum float32
+		for i := range a {
+			diff := a[i] - b[i]
+			sum += diff * diff
+		}
+		return sum
+	default:
+		var dot, normA, normB float32
+		for i := range a {
+			dot += a[i] * b[i]
+			normA += a[i] * a[i]
+			normB += b[i] * b[i]
+		}
+		if normA == 0 || normB == 0 {
+			return 1
+		}
+		return 1 - dot/float32(math.Sqrt(float64(normA))*math.Sqrt(float64(normB)))
+	}
+}
@@ -0,0 +1,223 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package benchmark generates synthetic collections and runs standardized
+// query benchmarks against them. It is meant for capacity validation of a
+// cluster, e.g. when evaluating new node types, and goes through the same
+// use-case layer as regular API requests.
+//
+// Generating a dataset and running a benchmark can put a node under heavy
+// load, so both are admin operations that require cluster permissions. They
+// run as background jobs on the node that received the request; the calling
+// principal is kept for the imports and queries of a job. The spec of a
+// dataset is only kept by the node that generated it, and only once all of
+// its objects were imported, so benchmarks have to be run on that node.
+package benchmark
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+type schemaManager interface {
+	AddClass(ctx context.Context, principal *models.Principal,
+		class *models.Class) (*models.Class, uint64, error)
+	GetClass(ctx context.Context, principal *models.Principal,
+		name string) (*models.Class, error)
+}
+
+type batchManager interface {
+	AddObjects(ctx context.Context, principal *models.Principal,
+		objects []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+	) (objects.BatchObjects, error)
+}
+
+type searcher interface {
+	GetClass(ctx context.Context, principal *models.Principal,
+		params dto.GetParams) ([]interface{}, error)
+}
+
+// Harness generates synthetic datasets and benchmarks queries against them.
+// The spec of every dataset is kept in the data path, it is required to
+// compute the recall of vector searches against exact ground truth.
+type Harness struct {
+	schemaManager schemaManager
+	batchManager  batchManager
+	searcher      searcher
+	authorizer    authorization.Authorizer
+	logger        logrus.FieldLogger
+	jobs          *jobs
+	specs         *specs
+}
+
+// New creates a Harness that keeps the specs of its datasets in dataPath. If
+// dataPath is empty, they are only kept in memory.
+func New(dataPath string, schemaManager schemaManager, batchManager batchManager,
+	searcher searcher, authorizer authorization.Authorizer, logger logrus.FieldLogger,
+) *Harness {
+	return &Harness{
+		schemaManager: schemaManager,
+		batchManager:  batchManager,
+		searcher:      searcher,
+		authorizer:    authorizer,
+		logger:        logger,
+		jobs:          newJobs(logger),
+		specs:         newSpecs(dataPath),
+	}
+}
+
+// GenerateReport summarizes a dataset import
+type GenerateReport struct {
+	Collection    string  `json:"collection"`
+	Objects       int     `json:"objects"`
+	Errors        int     `json:"errors"`
+	DurationMs    float64 `json:"durationMs"`
+	ObjectsPerSec float64 `json:"objectsPerSecond"`
+}
+
+// Generate creates the collection described by spec. The objects are
+// imported in batches by a background job, whose state can be polled with
+// Job. The spec is stored once all objects were imported without errors.
+func (h *Harness) Generate(ctx context.Context, principal *models.Principal,
+	spec DatasetSpec,
+) (*Job, error) {
+	if err := h.authorizer.Authorize(principal, authorization.UPDATE, authorization.Cluster()); err != nil {
+		return nil, err
+	}
+
+	spec.setDefaults()
+	if err := spec.validate(); err != nil {
+		return nil, fmt.Errorf("invalid dataset: %w", err)
+	}
+
+	// only one job runs at a time, so reserve the slot before creating the
+	// collection
+	job, err := h.jobs.reserve(JobTypeDataset, spec.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	// a spec left over from a deleted collection of the same name must not
+	// be used for the new dataset
+	if err := h.specs.delete(spec.Collection); err != nil {
+		h.jobs.release(job)
+		return nil, err
+	}
+	if _, _, err := h.schemaManager.AddClass(ctx, principal, spec.class()); err != nil {
+		h.jobs.release(job)
+		return nil, fmt.Errorf("create collection: %w", err)
+	}
+
+	return h.jobs.start(job, func(ctx context.Context) (interface{}, error) {
+		return h.generate(ctx, principal, spec)
+	}), nil
+}
+
+func (h *Harness) generate(ctx context.Context, principal *models.Principal,
+	spec DatasetSpec,
+) (*GenerateReport, error) {
+	logger := h.logger.WithFields(logrus.Fields{
+		"action":     "benchmark_generate",
+		"collection": spec.Collection,
+		"objects":    spec.Objects,
+	})
+	logger.Info("generating synthetic dataset")
+
+	report := &GenerateReport{Collection: spec.Collection}
+	start := time.Now()
+	data := newDataset(spec)
+	batch := make([]*models.Object, 0, spec.BatchSize)
+	for data.hasNext() {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		batch = append(batch, data.nextObject())
+		if len(batch) < spec.BatchSize && data.hasNext() {
+			continue
+		}
+
+		res, err := h.batchManager.AddObjects(ctx, principal, batch, nil, nil)
+		if err != nil {
+			return report, fmt.Errorf("import batch: %w", err)
+		}
+		for _, obj := range res {
+			if obj.Err != nil {
+				report.Errors++
+				continue
+			}
+			report.Objects++
+		}
+		batch = batch[:0]
+	}
+
+	took := time.Since(start)
+	report.DurationMs = ms(took)
+	report.ObjectsPerSec = float64(report.Objects) / took.Seconds()
+	logger.WithField("took", took).Info("finished generating synthetic dataset")
+
+	// recall is computed against the whole dataset, so a dataset that lacks
+	// objects can't be benchmarked
+	if report.Errors > 0 {
+		return report, fmt.Errorf("%d objects failed to import, the dataset can't be used for runs",
+			report.Errors)
+	}
+	if err := h.specs.put(spec); err != nil {
+		return report, fmt.Errorf("store dataset spec: %w", err)
+	}
+	return report, nil
+}
+
+// Job returns the state of a job started on this node
+func (h *Harness) Job(ctx context.Context, principal *models.Principal, id string) (*Job, error) {
+	if err := h.authorizer.Authorize(principal, authorization.READ, authorization.Cluster()); err != nil {
+		return nil, err
+	}
+	return h.jobs.get(id)
+}
+
+// Shutdown cancels the running job and waits for it to stop
+func (h *Harness) Shutdown(ctx context.Context) error {
+	return h.jobs.shutdown(ctx)
+}
+
+// dataset returns the spec of a collection that was generated completely by
+// this node
+func (h *Harness) dataset(ctx context.Context, principal *models.Principal,
+	collection string,
+) (DatasetSpec, error) {
+	class, err := h.schemaManager.GetClass(ctx, principal, collection)
+	if err != nil {
+		return DatasetSpec{}, err
+	}
+	if class == nil {
+		return DatasetSpec{}, fmt.Errorf("collection %q does not exist", collection)
+	}
+
+	spec, ok, err := h.specs.get(collection)
+	if err != nil {
+		return DatasetSpec{}, err
+	}
+	if !ok {
+		return DatasetSpec{}, fmt.Errorf("collection %q was not generated completely by the "+
+			"benchmark harness on this node", collection)
+	}
+	return spec, nil
+}
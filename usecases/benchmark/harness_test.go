@@ -0,0 +1,477 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package benchmark
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+type fakeSchema struct {
+	classes map[string]*models.Class
+}
+
+func (f *fakeSchema) AddClass(ctx context.Context, principal *models.Principal,
+	class *models.Class,
+) (*models.Class, uint64, error) {
+	f.classes[class.Class] = class
+	return class, 0, nil
+}
+
+func (f *fakeSchema) GetClass(ctx context.Context, principal *models.Principal,
+	name string,
+) (*models.Class, error) {
+	return f.classes[name], nil
+}
+
+// fakeDB stores imported objects and answers searches with an exact brute
+// force search, so recall must always be perfect
+type fakeDB struct {
+	sync.Mutex
+	schema  *fakeSchema
+	objects []*models.Object
+	batches int
+	// failing objects are not imported
+	failing map[strfmt.UUID]struct{}
+}
+
+func newFakeDB(schema *fakeSchema) *fakeDB {
+	return &fakeDB{schema: schema, failing: map[strfmt.UUID]struct{}{}}
+}
+
+func (f *fakeDB) AddObjects(ctx context.Context, principal *models.Principal,
+	objs []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+) (objects.BatchObjects, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.batches++
+	res := make(objects.BatchObjects, len(objs))
+	for i, obj := range objs {
+		res[i] = objects.BatchObject{Object: obj, UUID: obj.ID, OriginalIndex: i}
+		if _, ok := f.failing[obj.ID]; ok {
+			res[i].Err = errors.New("import failed")
+			continue
+		}
+		f.objects = append(f.objects, obj)
+	}
+	return res, nil
+}
+
+func (f *fakeDB) datasetObjects() []*models.Object {
+	f.Lock()
+	defer f.Unlock()
+	return append([]*models.Object{}, f.objects...)
+}
+
+// waitForJob polls a job until it is finished
+func waitForJob(t *testing.T, h *Harness, job *Job) *Job {
+	t.Helper()
+
+	var res *Job
+	require.Eventually(t, func() bool {
+		var err error
+		res, err = h.Job(context.Background(), nil, job.ID)
+		return err == nil && res.Status != JobStatusStarted
+	}, 5*time.Second, time.Millisecond)
+	return res
+}
+
+func (f *fakeDB) GetClass(ctx context.Context, principal *models.Principal,
+	params dto.GetParams,
+) ([]interface{}, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	query := params.NearVector.Vectors[0].([]float32)
+	metric := f.schema.classes[params.ClassName].VectorIndexConfig.(map[string]interface{})["distance"].(string)
+	sorted := make([]*models.Object, len(f.objects))
+	copy(sorted, f.objects)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return distance(metric, query, sorted[i].Vector) < distance(metric, query, sorted[j].Vector)
+	})
+
+	res := make([]interface{}, 0, params.Pagination.Limit)
+	for _, obj := range sorted[:params.Pagination.Limit] {
+		res = append(res, map[string]interface{}{
+			"_additional": map[string]interface{}{"id": obj.ID},
+		})
+	}
+	return res, nil
+}
+
+func TestHarness(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	schemaManager := &fakeSchema{classes: map[string]*models.Class{}}
+	db := newFakeDB(schemaManager)
+	dataPath := t.TempDir()
+	h := New(dataPath, schemaManager, db, db, mocks.NewMockAuthorizer(), logger)
+	ctx := context.Background()
+
+	job, err := h.Generate(ctx, nil, DatasetSpec{
+		Collection: "Synthetic",
+		Objects:    250,
+		Dimensions: 8,
+		BatchSize:  100,
+		Seed:       7,
+		Properties: []PropertySpec{
+			{Name: "category", DataType: schema.DataTypeText.String(), Distribution: DistributionZipf, Cardinality: 5},
+			{Name: "price", DataType: schema.DataTypeNumber.String(), Distribution: DistributionNormal},
+		},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, JobTypeDataset, job.Type)
+	assert.Equal(t, JobStatusStarted, job.Status)
+
+	job = waitForJob(t, h, job)
+	require.Equal(t, JobStatusSuccess, job.Status, job.Error)
+	assert.Equal(t, 250, job.Dataset.Objects)
+	assert.Equal(t, 0, job.Dataset.Errors)
+	assert.Equal(t, 3, db.batches)
+	assert.Len(t, schemaManager.classes["Synthetic"].Properties, 2)
+	assert.Len(t, schemaManager.classes, 1, "specs must not be stored in a collection")
+	assert.NotContains(t, schemaManager.classes["Synthetic"].Description, "{")
+
+	t.Run("dataset is deterministic", func(t *testing.T) {
+		spec, err := h.dataset(ctx, nil, "Synthetic")
+		require.Nil(t, err)
+		data := newDataset(spec)
+		for _, obj := range db.datasetObjects() {
+			assert.Equal(t, obj, data.nextObject())
+		}
+		assert.False(t, data.hasNext())
+	})
+
+	t.Run("zipf values stay within cardinality", func(t *testing.T) {
+		seen := map[string]struct{}{}
+		for _, obj := range db.datasetObjects() {
+			seen[obj.Properties.(map[string]interface{})["category"].(string)] = struct{}{}
+		}
+		assert.LessOrEqual(t, len(seen), 5)
+	})
+
+	t.Run("run with recall", func(t *testing.T) {
+		job, err := h.Run(ctx, nil, RunSpec{
+			Collection:  "Synthetic",
+			Queries:     20,
+			Concurrency: 4,
+			Limit:       5,
+			Recall:      true,
+		})
+		require.Nil(t, err)
+		job = waitForJob(t, h, job)
+		require.Equal(t, JobStatusSuccess, job.Status, job.Error)
+
+		run := job.Run
+		assert.Equal(t, 20, run.Queries)
+		assert.Equal(t, 0, run.Errors)
+		require.NotNil(t, run.Recall)
+		assert.Equal(t, 1.0, *run.Recall)
+		assert.LessOrEqual(t, run.Latency.P50, run.Latency.P99)
+		assert.Greater(t, run.ThroughputQPS, 0.0)
+	})
+
+	t.Run("run after restart", func(t *testing.T) {
+		restarted := New(dataPath, schemaManager, db, db, mocks.NewMockAuthorizer(), logger)
+		job, err := restarted.Run(ctx, nil, RunSpec{
+			Collection: "synthetic",
+			Queries:    5,
+			Limit:      5,
+			Recall:     true,
+		})
+		require.Nil(t, err)
+		job = waitForJob(t, restarted, job)
+		require.Equal(t, JobStatusSuccess, job.Status, job.Error)
+		require.NotNil(t, job.Run.Recall)
+		assert.Equal(t, 1.0, *job.Run.Recall)
+	})
+
+	t.Run("unknown collection", func(t *testing.T) {
+		_, err := h.Run(ctx, nil, RunSpec{Collection: "Unknown"})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("unknown job", func(t *testing.T) {
+		_, err := h.Job(ctx, nil, "unknown")
+		assert.ErrorIs(t, err, ErrJobNotFound)
+	})
+
+	t.Run("collection not generated by the harness", func(t *testing.T) {
+		schemaManager.classes["Regular"] = &models.Class{Class: "Regular", Description: "a regular collection"}
+		_, err := h.Run(ctx, nil, RunSpec{Collection: "Regular"})
+		assert.ErrorContains(t, err, "not generated completely")
+	})
+
+	t.Run("incompletely imported dataset", func(t *testing.T) {
+		db.failing[objectID("Partial", 3)] = struct{}{}
+		job, err := h.Generate(ctx, nil, DatasetSpec{Collection: "Partial", Objects: 10, Dimensions: 2})
+		require.Nil(t, err)
+		job = waitForJob(t, h, job)
+		assert.Equal(t, JobStatusFailed, job.Status)
+		assert.Equal(t, 1, job.Dataset.Errors)
+
+		_, err = h.Run(ctx, nil, RunSpec{Collection: "Partial", Recall: true})
+		assert.ErrorContains(t, err, "not generated completely")
+	})
+
+	t.Run("recall over the ground truth limit", func(t *testing.T) {
+		spec := RunSpec{Collection: "Synthetic", Queries: MaxQueries, Recall: true}
+		spec.setDefaults()
+		err := spec.validateFor(DatasetSpec{Objects: MaxObjects, Dimensions: MaxDimensions})
+		assert.ErrorContains(t, err, "ground truth limit")
+	})
+
+	t.Run("bm25 without text property", func(t *testing.T) {
+		job, err := h.Generate(ctx, nil, DatasetSpec{Collection: "NoText", Objects: 1, Dimensions: 1})
+		require.Nil(t, err)
+		waitForJob(t, h, job)
+		_, err = h.Run(ctx, nil, RunSpec{Collection: "NoText", QueryType: QueryTypeBM25})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestHarnessRequiresClusterPermissions(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	schemaManager := &fakeSchema{classes: map[string]*models.Class{}}
+	db := newFakeDB(schemaManager)
+	authorizer := mocks.NewMockAuthorizer()
+	authorizer.SetErr(errors.New("forbidden"))
+	h := New("", schemaManager, db, db, authorizer, logger)
+	ctx := context.Background()
+
+	_, err := h.Generate(ctx, nil, DatasetSpec{Collection: "Synthetic", Objects: 1, Dimensions: 1})
+	assert.ErrorContains(t, err, "forbidden")
+	_, err = h.Run(ctx, nil, RunSpec{Collection: "Synthetic"})
+	assert.ErrorContains(t, err, "forbidden")
+	_, err = h.Job(ctx, nil, "any")
+	assert.ErrorContains(t, err, "forbidden")
+	assert.Empty(t, schemaManager.classes)
+
+	calls := authorizer.Calls()
+	require.Len(t, calls, 3)
+	assert.Equal(t, authorization.UPDATE, calls[0].Verb)
+	assert.Equal(t, []string{authorization.Cluster()}, calls[0].Resources)
+	assert.Equal(t, authorization.UPDATE, calls[1].Verb)
+	assert.Equal(t, authorization.READ, calls[2].Verb)
+}
+
+// blockingDB blocks imports until it is released
+type blockingDB struct {
+	*fakeDB
+	release chan struct{}
+}
+
+func (b *blockingDB) AddObjects(ctx context.Context, principal *models.Principal,
+	objs []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+) (objects.BatchObjects, error) {
+	select {
+	case <-b.release:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return b.fakeDB.AddObjects(ctx, principal, objs, fields, repl)
+}
+
+func TestHarnessRunsOneJobAtATime(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	schemaManager := &fakeSchema{classes: map[string]*models.Class{}}
+	db := &blockingDB{fakeDB: newFakeDB(schemaManager), release: make(chan struct{})}
+	h := New("", schemaManager, db, db, mocks.NewMockAuthorizer(), logger)
+	ctx := context.Background()
+
+	first, err := h.Generate(ctx, nil, DatasetSpec{Collection: "First", Objects: 10, Dimensions: 2})
+	require.Nil(t, err)
+
+	// the job is running, so the dataset is not complete yet
+	_, err = h.dataset(ctx, nil, "First")
+	assert.ErrorContains(t, err, "not generated completely")
+
+	_, err = h.Generate(ctx, nil, DatasetSpec{Collection: "Second", Objects: 10, Dimensions: 2})
+	assert.ErrorIs(t, err, ErrJobRunning)
+	assert.Nil(t, schemaManager.classes["Second"])
+
+	close(db.release)
+	assert.Equal(t, JobStatusSuccess, waitForJob(t, h, first).Status)
+
+	second, err := h.Generate(ctx, nil, DatasetSpec{Collection: "Second", Objects: 10, Dimensions: 2})
+	require.Nil(t, err)
+	assert.Equal(t, JobStatusSuccess, waitForJob(t, h, second).Status)
+
+	t.Run("shutdown cancels the running job", func(t *testing.T) {
+		db.release = make(chan struct{})
+		job, err := h.Generate(ctx, nil, DatasetSpec{Collection: "Third", Objects: 10, Dimensions: 2})
+		require.Nil(t, err)
+
+		require.Nil(t, h.Shutdown(ctx))
+		job, err = h.Job(ctx, nil, job.ID)
+		require.Nil(t, err)
+		assert.Equal(t, JobStatusFailed, job.Status)
+
+		_, err = h.Generate(ctx, nil, DatasetSpec{Collection: "Fourth", Objects: 10, Dimensions: 2})
+		assert.ErrorContains(t, err, "shutting down")
+	})
+}
+
+func TestThroughputCountsSuccessfulQueriesOnly(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	schemaManager := &fakeSchema{classes: map[string]*models.Class{}}
+	db := newFakeDB(schemaManager)
+	h := New("", schemaManager, db, failingSearcher{}, mocks.NewMockAuthorizer(), logger)
+	ctx := context.Background()
+
+	job, err := h.Generate(ctx, nil, DatasetSpec{Collection: "Failing", Objects: 10, Dimensions: 2})
+	require.Nil(t, err)
+	waitForJob(t, h, job)
+
+	job, err = h.Run(ctx, nil, RunSpec{Collection: "Failing", Queries: 10})
+	require.Nil(t, err)
+	job = waitForJob(t, h, job)
+	require.Equal(t, JobStatusSuccess, job.Status, job.Error)
+	assert.Equal(t, 10, job.Run.Errors)
+	assert.Equal(t, 0.0, job.Run.ThroughputQPS)
+}
+
+type failingSearcher struct{}
+
+func (failingSearcher) GetClass(ctx context.Context, principal *models.Principal,
+	params dto.GetParams,
+) ([]interface{}, error) {
+	return nil, fmt.Errorf("search failed")
+}
+
+func TestDatasetSpecValidation(t *testing.T) {
+	valid := func() DatasetSpec {
+		return DatasetSpec{Collection: "Synthetic", Objects: 10, Dimensions: 4}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*DatasetSpec)
+		valid  bool
+	}{
+		{name: "defaults", modify: func(s *DatasetSpec) {}, valid: true},
+		{name: "invalid collection", modify: func(s *DatasetSpec) { s.Collection = "1nvalid" }},
+		{name: "no objects", modify: func(s *DatasetSpec) { s.Objects = 0 }},
+		{name: "no dimensions", modify: func(s *DatasetSpec) { s.Dimensions = 0 }},
+		{name: "too many objects", modify: func(s *DatasetSpec) { s.Objects = MaxObjects + 1 }},
+		{name: "too many dimensions", modify: func(s *DatasetSpec) { s.Dimensions = MaxDimensions + 1 }},
+		{name: "batch too large", modify: func(s *DatasetSpec) { s.BatchSize = MaxBatchSize + 1 }},
+		{
+			name: "cardinality too large",
+			modify: func(s *DatasetSpec) {
+				s.Properties = []PropertySpec{{Name: "rank", DataType: "int", Cardinality: MaxCardinality + 1}}
+			},
+		},
+		{name: "unsupported distance", modify: func(s *DatasetSpec) { s.Distance = "hamming" }},
+		{
+			name: "zipf number",
+			modify: func(s *DatasetSpec) {
+				s.Properties = []PropertySpec{{Name: "price", DataType: "number", Distribution: DistributionZipf}}
+			},
+		},
+		{
+			name: "unsupported data type",
+			modify: func(s *DatasetSpec) {
+				s.Properties = []PropertySpec{{Name: "when", DataType: "date"}}
+			},
+		},
+		{
+			name: "zipf int",
+			modify: func(s *DatasetSpec) {
+				s.Properties = []PropertySpec{{Name: "rank", DataType: "int", Distribution: DistributionZipf}}
+			},
+			valid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := valid()
+			tt.modify(&spec)
+			spec.setDefaults()
+			err := spec.validate()
+			if tt.valid {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestRunSpecValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		spec  RunSpec
+		valid bool
+	}{
+		{name: "defaults", spec: RunSpec{}, valid: true},
+		{name: "unsupported query type", spec: RunSpec{QueryType: "hybrid"}},
+		{name: "too many queries", spec: RunSpec{Queries: MaxQueries + 1}},
+		{name: "too much concurrency", spec: RunSpec{Concurrency: MaxConcurrency + 1}},
+		{name: "limit too large", spec: RunSpec{Limit: MaxLimit + 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.spec.setDefaults()
+			err := tt.spec.validate()
+			if tt.valid {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestLatencyPercentiles(t *testing.T) {
+	latencies := make([]time.Duration, 100)
+	for i := range latencies {
+		latencies[len(latencies)-1-i] = time.Duration(i+1) * time.Millisecond
+	}
+
+	l := latencyPercentiles(latencies)
+	assert.Equal(t, 50.5, l.Mean)
+	assert.Equal(t, 50.0, l.P50)
+	assert.Equal(t, 90.0, l.P90)
+	assert.Equal(t, 99.0, l.P99)
+	assert.Equal(t, 100.0, l.Max)
+	assert.Equal(t, Latency{}, latencyPercentiles(nil))
+}
+
+func TestInsertNeighbor(t *testing.T) {
+	var list []neighbor
+	for i, d := range []float32{5, 1, 4, 2, 3} {
+		list = insertNeighbor(list, neighbor{id: objectID("c", i), dist: d}, 3)
+	}
+	require.Len(t, list, 3)
+	assert.Equal(t, []float32{1, 2, 3}, []float32{list[0].dist, list[1].dist, list[2].dist})
+}
@@ -0,0 +1,192 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package benchmark
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+const (
+	JobTypeDataset = "dataset"
+	JobTypeRun     = "run"
+
+	JobStatusStarted = "STARTED"
+	JobStatusSuccess = "SUCCESS"
+	JobStatusFailed  = "FAILED"
+
+	// finished jobs are kept so that their results can be polled, the oldest
+	// ones are dropped once there are more
+	maxFinishedJobs = 100
+)
+
+var (
+	ErrJobRunning  = errors.New("another benchmark job is running on this node")
+	ErrJobNotFound = errors.New("benchmark job not found")
+)
+
+// Job is a dataset generation or a benchmark run. Jobs only exist on the
+// node that started them and are lost on restart; a generated dataset is
+// kept regardless.
+type Job struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Collection string `json:"collection"`
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+
+	// Dataset is set for dataset jobs, Run for finished run jobs
+	Dataset *GenerateReport `json:"dataset,omitempty"`
+	Run     *Report         `json:"run,omitempty"`
+}
+
+// jobs runs at most one job at a time, so that concurrent imports and
+// benchmarks neither overload a node nor distort each other's results
+type jobs struct {
+	sync.Mutex
+	logger logrus.FieldLogger
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	byID     map[string]*Job
+	finished []string
+	running  *Job
+}
+
+func newJobs(logger logrus.FieldLogger) *jobs {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &jobs{
+		logger: logger,
+		ctx:    ctx,
+		cancel: cancel,
+		byID:   map[string]*Job{},
+	}
+}
+
+// reserve returns a new job if no other job is running. It has to be either
+// started or released.
+func (j *jobs) reserve(typ, collection string) (*Job, error) {
+	j.Lock()
+	defer j.Unlock()
+
+	if j.ctx.Err() != nil {
+		return nil, fmt.Errorf("benchmark harness is shutting down")
+	}
+	if j.running != nil {
+		return nil, fmt.Errorf("%w: %s job %s for collection %q", ErrJobRunning,
+			j.running.Type, j.running.ID, j.running.Collection)
+	}
+
+	j.running = &Job{
+		ID:         uuid.NewString(),
+		Type:       typ,
+		Collection: collection,
+		Status:     JobStatusStarted,
+	}
+	return j.running, nil
+}
+
+func (j *jobs) release(job *Job) {
+	j.Lock()
+	defer j.Unlock()
+
+	if j.running == job {
+		j.running = nil
+	}
+}
+
+// start runs a reserved job in the background and returns a snapshot of it.
+// The result of run is either a *GenerateReport or a *Report.
+func (j *jobs) start(job *Job, run func(ctx context.Context) (interface{}, error)) *Job {
+	j.Lock()
+	j.byID[job.ID] = job
+	snapshot := *job
+	j.wg.Add(1)
+	j.Unlock()
+
+	enterrors.GoWrapper(func() {
+		defer j.wg.Done()
+		res, err := run(j.ctx)
+		j.finish(job, res, err)
+	}, j.logger)
+
+	return &snapshot
+}
+
+func (j *jobs) finish(job *Job, res interface{}, err error) {
+	j.Lock()
+	defer j.Unlock()
+
+	switch r := res.(type) {
+	case *GenerateReport:
+		job.Dataset = r
+	case *Report:
+		job.Run = r
+	}
+
+	job.Status = JobStatusSuccess
+	if err != nil {
+		job.Status = JobStatusFailed
+		job.Error = err.Error()
+		j.logger.WithFields(logrus.Fields{
+			"action":     "benchmark_job",
+			"job":        job.ID,
+			"type":       job.Type,
+			"collection": job.Collection,
+		}).WithError(err).Error("benchmark job failed")
+	}
+
+	j.running = nil
+	j.finished = append(j.finished, job.ID)
+	if len(j.finished) > maxFinishedJobs {
+		delete(j.byID, j.finished[0])
+		j.finished = j.finished[1:]
+	}
+}
+
+// get returns a snapshot of a job
+func (j *jobs) get(id string) (*Job, error) {
+	j.Lock()
+	defer j.Unlock()
+
+	job, ok := j.byID[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrJobNotFound, id)
+	}
+	snapshot := *job
+	return &snapshot, nil
+}
+
+func (j *jobs) shutdown(ctx context.Context) error {
+	j.cancel()
+
+	done := make(chan struct{})
+	enterrors.GoWrapper(func() {
+		j.wg.Wait()
+		close(done)
+	}, j.logger)
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,424 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package benchmark
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+const (
+	QueryTypeNearVector = "nearVector"
+	QueryTypeBM25       = "bm25"
+
+	DefaultQueries     = 100
+	DefaultConcurrency = 1
+	DefaultLimit       = 10
+
+	MaxQueries     = 10_000
+	MaxConcurrency = 64
+	MaxLimit       = 100
+	// MaxRecallComponents bounds the exact ground truth of a run, which
+	// compares every query vector with every object of the dataset. It is the
+	// product of the objects, queries and dimensions.
+	MaxRecallComponents = 100_000_000_000
+)
+
+// RunSpec describes a query benchmark against a generated dataset
+type RunSpec struct {
+	Collection string `json:"collection"`
+	// QueryType is either "nearVector" (default) or "bm25"
+	QueryType   string `json:"queryType"`
+	Queries     int    `json:"queries"`
+	Concurrency int    `json:"concurrency"`
+	Limit       int    `json:"limit"`
+	// Recall compares nearVector results against an exact brute force search
+	// over the generated dataset
+	Recall bool  `json:"recall"`
+	Seed   int64 `json:"seed"`
+}
+
+func (s *RunSpec) setDefaults() {
+	s.Collection = schema.UppercaseClassName(s.Collection)
+	if s.QueryType == "" {
+		s.QueryType = QueryTypeNearVector
+	}
+	if s.Queries <= 0 {
+		s.Queries = DefaultQueries
+	}
+	if s.Concurrency <= 0 {
+		s.Concurrency = DefaultConcurrency
+	}
+	if s.Limit <= 0 {
+		s.Limit = DefaultLimit
+	}
+}
+
+func (s RunSpec) validate() error {
+	if s.QueryType != QueryTypeNearVector && s.QueryType != QueryTypeBM25 {
+		return fmt.Errorf("unsupported query type %q", s.QueryType)
+	}
+	if s.Queries > MaxQueries {
+		return fmt.Errorf("queries must not be greater than %d", MaxQueries)
+	}
+	if s.Concurrency > MaxConcurrency {
+		return fmt.Errorf("concurrency must not be greater than %d", MaxConcurrency)
+	}
+	if s.Limit > MaxLimit {
+		return fmt.Errorf("limit must not be greater than %d", MaxLimit)
+	}
+	return nil
+}
+
+// validateFor checks the parts of a run that depend on its dataset
+func (s RunSpec) validateFor(dataset DatasetSpec) error {
+	if s.QueryType == QueryTypeBM25 && textProperty(dataset) == nil {
+		return fmt.Errorf("collection %q has no text property to run bm25 queries on", dataset.Collection)
+	}
+	if s.Recall && s.QueryType == QueryTypeNearVector &&
+		int64(dataset.Objects)*int64(s.Queries)*int64(dataset.Dimensions) > MaxRecallComponents {
+		return fmt.Errorf("recall of %d queries against %d objects with %d dimensions exceeds the "+
+			"ground truth limit of %d vector components, run fewer queries",
+			s.Queries, dataset.Objects, dataset.Dimensions, int64(MaxRecallComponents))
+	}
+	return nil
+}
+
+// Latency percentiles in milliseconds
+type Latency struct {
+	Mean float64 `json:"mean"`
+	P50  float64 `json:"p50"`
+	P90  float64 `json:"p90"`
+	P99  float64 `json:"p99"`
+	Max  float64 `json:"max"`
+}
+
+// Report summarizes a benchmark run
+type Report struct {
+	Collection    string   `json:"collection"`
+	QueryType     string   `json:"queryType"`
+	Queries       int      `json:"queries"`
+	Errors        int      `json:"errors"`
+	Concurrency   int      `json:"concurrency"`
+	DurationMs    float64  `json:"durationMs"`
+	ThroughputQPS float64  `json:"throughputQPS"`
+	Latency       Latency  `json:"latencyMs"`
+	Recall        *float64 `json:"recall,omitempty"`
+}
+
+type queryResult struct {
+	took time.Duration
+	ids  []strfmt.UUID
+	err  error
+}
+
+// Run starts a background job that executes spec.Queries queries against
+// a dataset previously created with Generate. The job reports latency
+// percentiles, throughput and, if requested, recall.
+func (h *Harness) Run(ctx context.Context, principal *models.Principal,
+	spec RunSpec,
+) (*Job, error) {
+	if err := h.authorizer.Authorize(principal, authorization.UPDATE, authorization.Cluster()); err != nil {
+		return nil, err
+	}
+
+	spec.setDefaults()
+	if err := spec.validate(); err != nil {
+		return nil, fmt.Errorf("invalid run: %w", err)
+	}
+
+	dataset, err := h.dataset(ctx, principal, spec.Collection)
+	if err != nil {
+		return nil, err
+	}
+	if err := spec.validateFor(dataset); err != nil {
+		return nil, fmt.Errorf("invalid run: %w", err)
+	}
+
+	job, err := h.jobs.reserve(JobTypeRun, spec.Collection)
+	if err != nil {
+		return nil, err
+	}
+	return h.jobs.start(job, func(ctx context.Context) (interface{}, error) {
+		return h.run(ctx, principal, dataset, spec)
+	}), nil
+}
+
+func (h *Harness) run(ctx context.Context, principal *models.Principal,
+	dataset DatasetSpec, spec RunSpec,
+) (*Report, error) {
+	queries := h.queries(dataset, spec)
+
+	logger := h.logger.WithFields(logrus.Fields{
+		"action":      "benchmark_run",
+		"collection":  spec.Collection,
+		"query_type":  spec.QueryType,
+		"queries":     spec.Queries,
+		"concurrency": spec.Concurrency,
+	})
+	logger.Info("starting benchmark run")
+
+	results := make([]queryResult, len(queries))
+	next := make(chan int)
+	wg := &sync.WaitGroup{}
+	start := time.Now()
+	for w := 0; w < spec.Concurrency; w++ {
+		wg.Add(1)
+		enterrors.GoWrapper(func() {
+			defer wg.Done()
+			for i := range next {
+				results[i] = h.query(ctx, principal, queries[i])
+			}
+		}, h.logger)
+	}
+	for i := range queries {
+		if ctx.Err() != nil {
+			break
+		}
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+	took := time.Since(start)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		Collection:  spec.Collection,
+		QueryType:   spec.QueryType,
+		Queries:     len(queries),
+		Concurrency: spec.Concurrency,
+		DurationMs:  ms(took),
+	}
+
+	latencies := make([]time.Duration, 0, len(results))
+	for _, res := range results {
+		if res.err != nil {
+			report.Errors++
+			continue
+		}
+		latencies = append(latencies, res.took)
+	}
+	if report.Errors > 0 {
+		logger.WithField("errors", report.Errors).
+			WithError(firstError(results)).
+			Warn("benchmark queries failed")
+	}
+	report.Latency = latencyPercentiles(latencies)
+	// failed queries often return early, so they would inflate the throughput
+	report.ThroughputQPS = float64(len(latencies)) / took.Seconds()
+
+	if spec.Recall && spec.QueryType == QueryTypeNearVector {
+		vectors := make([][]float32, len(queries))
+		for i := range queries {
+			vectors[i] = queries[i].NearVector.Vectors[0].([]float32)
+		}
+		truth, err := groundTruth(ctx, dataset, vectors, spec.Limit)
+		if err != nil {
+			return nil, fmt.Errorf("compute ground truth: %w", err)
+		}
+		recall := recallAgainst(truth, results)
+		report.Recall = &recall
+	}
+
+	logger.WithField("took", took).Info("finished benchmark run")
+	return report, nil
+}
+
+// queries builds the query params of a run. Query vectors and terms are
+// drawn from a separate seed, so they do not repeat the dataset itself.
+func (h *Harness) queries(dataset DatasetSpec, spec RunSpec) []dto.GetParams {
+	r := rand.New(rand.NewSource(dataset.Seed + spec.Seed + 1))
+
+	var textProp *PropertySpec
+	if spec.QueryType == QueryTypeBM25 {
+		textProp = textProperty(dataset)
+	}
+
+	queries := make([]dto.GetParams, spec.Queries)
+	for i := range queries {
+		params := dto.GetParams{
+			ClassName:            dataset.Collection,
+			Pagination:           &filters.Pagination{Limit: spec.Limit},
+			AdditionalProperties: additional.Properties{ID: true},
+		}
+		if textProp != nil {
+			params.KeywordRanking = &searchparams.KeywordRanking{
+				Type:       "bm25",
+				Properties: []string{textProp.Name},
+				Query:      word(textProp.Name, r.Intn(textProp.Cardinality)),
+			}
+		} else {
+			params.NearVector = &searchparams.NearVector{
+				Vectors: []models.Vector{randomVector(r, dataset.Dimensions)},
+			}
+		}
+		queries[i] = params
+	}
+	return queries
+}
+
+func textProperty(dataset DatasetSpec) *PropertySpec {
+	for i := range dataset.Properties {
+		if dataset.Properties[i].DataType == schema.DataTypeText.String() {
+			return &dataset.Properties[i]
+		}
+	}
+	return nil
+}
+
+func (h *Harness) query(ctx context.Context, principal *models.Principal,
+	params dto.GetParams,
+) queryResult {
+	start := time.Now()
+	res, err := h.searcher.GetClass(ctx, principal, params)
+	took := time.Since(start)
+	if err != nil {
+		return queryResult{took: took, err: err}
+	}
+
+	ids := make([]strfmt.UUID, 0, len(res))
+	for _, item := range res {
+		props, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		add, ok := props["_additional"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if id, ok := add["id"].(strfmt.UUID); ok {
+			ids = append(ids, id)
+		}
+	}
+	return queryResult{took: took, ids: ids}
+}
+
+type neighbor struct {
+	id   strfmt.UUID
+	dist float32
+}
+
+// groundTruth computes the exact nearest neighbors of all query vectors. The
+// dataset is regenerated and streamed once, so memory stays bounded by the
+// number of queries times k.
+func groundTruth(ctx context.Context, spec DatasetSpec, queries [][]float32, k int) ([][]neighbor, error) {
+	truth := make([][]neighbor, len(queries))
+	data := newDataset(spec)
+	for data.hasNext() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		obj := data.nextObject()
+		for i, q := range queries {
+			truth[i] = insertNeighbor(truth[i], neighbor{
+				id:   obj.ID,
+				dist: distance(spec.Distance, q, obj.Vector),
+			}, k)
+		}
+	}
+	return truth, nil
+}
+
+// insertNeighbor adds n to the sorted list if it is among the k closest
+func insertNeighbor(list []neighbor, n neighbor, k int) []neighbor {
+	if len(list) == k && list[k-1].dist <= n.dist {
+		return list
+	}
+	pos := sort.Search(len(list), func(i int) bool { return list[i].dist > n.dist })
+	if len(list) < k {
+		list = append(list, neighbor{})
+	}
+	copy(list[pos+1:], list[pos:len(list)-1])
+	list[pos] = n
+	return list
+}
+
+func recallAgainst(truth [][]neighbor, results []queryResult) float64 {
+	var found, total int
+	for i, res := range results {
+		if res.err != nil {
+			continue
+		}
+		expected := make(map[strfmt.UUID]struct{}, len(truth[i]))
+		for _, n := range truth[i] {
+			expected[n.id] = struct{}{}
+		}
+		for _, id := range res.ids {
+			if _, ok := expected[id]; ok {
+				found++
+			}
+		}
+		total += len(expected)
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(found) / float64(total)
+}
+
+func latencyPercentiles(latencies []time.Duration) Latency {
+	if len(latencies) == 0 {
+		return Latency{}
+	}
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	var sum time.Duration
+	for _, l := range latencies {
+		sum += l
+	}
+	percentile := func(p float64) float64 {
+		i := int(p*float64(len(latencies)) + 0.5)
+		if i > 0 {
+			i--
+		}
+		return ms(latencies[i])
+	}
+
+	return Latency{
+		Mean: ms(sum / time.Duration(len(latencies))),
+		P50:  percentile(0.5),
+		P90:  percentile(0.9),
+		P99:  percentile(0.99),
+		Max:  ms(latencies[len(latencies)-1]),
+	}
+}
+
+func firstError(results []queryResult) error {
+	for _, res := range results {
+		if res.err != nil {
+			return res.err
+		}
+	}
+	return nil
+}
+
+func ms(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
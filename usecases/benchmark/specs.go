@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package benchmark
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// specsFile is stored in the root of the data path. Collections can't have
+// a file ending, so it never collides with the directory of a collection.
+const specsFile = "benchmark_datasets.json"
+
+// specs holds the specs of the datasets that were imported completely by
+// this node. They are kept in a file, so that runs still work after a
+// restart. Without a path they are only kept in memory.
+type specs struct {
+	sync.Mutex
+	path   string
+	loaded bool
+	// map[collection]DatasetSpec
+	byCollection map[string]DatasetSpec
+}
+
+func newSpecs(dataPath string) *specs {
+	s := &specs{byCollection: map[string]DatasetSpec{}}
+	if dataPath != "" {
+		s.path = filepath.Join(dataPath, specsFile)
+	}
+	return s
+}
+
+// get returns the spec of a completely imported dataset
+func (s *specs) get(collection string) (DatasetSpec, bool, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if err := s.load(); err != nil {
+		return DatasetSpec{}, false, err
+	}
+	spec, ok := s.byCollection[collection]
+	return spec, ok, nil
+}
+
+// put stores the spec of a dataset once it was imported completely
+func (s *specs) put(spec DatasetSpec) error {
+	return s.update(func() { s.byCollection[spec.Collection] = spec })
+}
+
+// delete drops the spec of a dataset that is about to be generated again
+func (s *specs) delete(collection string) error {
+	return s.update(func() { delete(s.byCollection, collection) })
+}
+
+func (s *specs) update(fn func()) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if err := s.load(); err != nil {
+		return err
+	}
+	fn()
+	return s.store()
+}
+
+func (s *specs) load() error {
+	if s.loaded || s.path == "" {
+		return nil
+	}
+
+	content, err := os.ReadFile(s.path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("read dataset specs: %w", err)
+	}
+	if len(content) > 0 {
+		if err := json.Unmarshal(content, &s.byCollection); err != nil {
+			return fmt.Errorf("decode dataset specs: %w", err)
+		}
+	}
+	s.loaded = true
+	return nil
+}
+
+func (s *specs) store() error {
+	if s.path == "" {
+		return nil
+	}
+
+	content, err := json.Marshal(s.byCollection)
+	if err != nil {
+		return fmt.Errorf("encode dataset specs: %w", err)
+	}
+	// written to a temporary file first, so that a crash never leaves a
+	// partially written file behind
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, content, 0o644); err != nil {
+		return fmt.Errorf("write dataset specs: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("write dataset specs: %w", err)
+	}
+	return nil
+}
@@ -119,6 +119,9 @@ type delegate struct {
 
 	mutex    sync.Mutex
 	hostInfo NodeInfo
+
+	// stop ends the updater, a nil channel keeps it running forever
+	stop chan struct{}
 }
 
 func (d *delegate) setOwnSpace(x DiskUsage) {
@@ -150,7 +153,9 @@ func (d *delegate) init(diskSpace func(path string) (DiskUsage, error)) error {
 	d.setOwnSpace(space)
 	d.set(d.Name, NodeInfo{space, lastTime.UnixMilli()}) // cache
 
-	// delegate remains alive throughout the entire program.
+	// delegate remains alive until the State is shut down, which is usually
+	// the entire program.
+	d.stop = make(chan struct{})
 	enterrors.GoWrapper(func() { d.updater(_ProtoTTL, minUpdatePeriod, diskSpace) }, d.log)
 	return nil
 }
@@ -265,7 +270,13 @@ func (d *delegate) updater(period, minPeriod time.Duration, du func(path string)
 	t := time.NewTicker(period)
 	defer t.Stop()
 	curTime := time.Now()
-	for range t.C {
+	for {
+		select {
+		case <-d.stop:
+			return
+		case <-t.C:
+		}
+
 		if time.Since(curTime) < minPeriod { // too short
 			continue // wait for next cycle to avoid overwhelming the disk
 		}
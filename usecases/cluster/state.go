@@ -161,6 +161,19 @@ func Init(userConfig Config, dataPath string, nonStorageNodes map[string]struct{
 	return &state, nil
 }
 
+// Shutdown stops gossiping and releases the bind port without notifying the
+// other members, which detect the node as failed like after a crash
+func (s *State) Shutdown() error {
+	s.listLock.Lock()
+	defer s.listLock.Unlock()
+
+	if s.delegate.stop != nil {
+		close(s.delegate.stop)
+		s.delegate.stop = nil
+	}
+	return s.list.Shutdown()
+}
+
 // Hostnames for all live members, except self. Use AllHostnames to include
 // self, prefixes the data port.
 func (s *State) Hostnames() []string {
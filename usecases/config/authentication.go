@@ -50,7 +50,8 @@ func (a Authentication) AnyApiKeyAvailable() bool {
 // AnonymousAccess considers users without any auth information as
 // authenticated as "anonymous" rather than denying their request immediately.
 // Note that enabling anonymous access ONLY affects Authentication, not
-// Authorization.
+// Authorization. With RBAC, anonymous users have no permissions unless roles
+// are assigned to the "anonymous" group.
 type AnonymousAccess struct {
 	Enabled bool `json:"enabled" yaml:"enabled"`
 }
@@ -31,7 +31,10 @@ import (
 	entsentry "github.com/weaviate/weaviate/entities/sentry"
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
 	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/masking"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/qos"
+	"github.com/weaviate/weaviate/usecases/usage"
 )
 
 // ServerVersion is deprecated. Use `build.Version`. It's there for backward compatiblility.
@@ -137,6 +140,7 @@ type Config struct {
 	ReindexMapToBlockmaxConfig          MapToBlockamaxConfig     `json:"reindex_map_to_blockmax_config" yaml:"reindex_map_to_blockmax_config"`
 	IndexMissingTextFilterableAtStartup bool                     `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
 	DisableGraphQL                      bool                     `json:"disable_graphql" yaml:"disable_graphql"`
+	GraphQLMaxBatchSize                 int                      `json:"graphql_max_batch_size" yaml:"graphql_max_batch_size"`
 	AvoidMmap                           bool                     `json:"avoid_mmap" yaml:"avoid_mmap"`
 	CORS                                CORS                     `json:"cors" yaml:"cors"`
 	DisableTelemetry                    bool                     `json:"disable_telemetry" yaml:"disable_telemetry"`
@@ -147,6 +151,9 @@ type Config struct {
 	Sentry                              *entsentry.ConfigOpts    `json:"sentry" yaml:"sentry"`
 	MetadataServer                      MetadataServer           `json:"metadata_server" yaml:"metadata_server"`
 	SchemaHandlerConfig                 SchemaHandlerConfig      `json:"schema" yaml:"schema"`
+	DataMasking                         masking.Config           `json:"data_masking" yaml:"data_masking"`
+	Usage                               usage.Config             `json:"usage" yaml:"usage"`
+	QoS                                 qos.Config               `json:"qos" yaml:"qos"`
 
 	// Raft Specific configuration
 	// TODO-RAFT: Do we want to be able to specify these with config file as well ?
@@ -177,10 +184,6 @@ func (c *Config) Validate() error {
 		return configErr(err)
 	}
 
-	if c.Authentication.AnonymousAccess.Enabled && c.Authorization.Rbac.Enabled {
-		return fmt.Errorf("cannot enable anonymous access and rbac authorization")
-	}
-
 	if err := c.Persistence.Validate(); err != nil {
 		return configErr(err)
 	}
@@ -197,6 +200,18 @@ func (c *Config) Validate() error {
 		return configErr(err)
 	}
 
+	if err := c.DataMasking.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := c.Usage.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := c.QoS.Validate(); err != nil {
+		return configErr(err)
+	}
+
 	return nil
 }
 
@@ -26,6 +26,9 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/sentry"
 	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/masking"
+	"github.com/weaviate/weaviate/usecases/qos"
+	"github.com/weaviate/weaviate/usecases/usage"
 )
 
 const (
@@ -536,6 +539,13 @@ func FromEnv(config *Config) error {
 	}
 
 	config.DisableGraphQL = entcfg.Enabled(os.Getenv("DISABLE_GRAPHQL"))
+	if err := parsePositiveInt(
+		"GRAPHQL_MAX_BATCH_SIZE",
+		func(val int) { config.GraphQLMaxBatchSize = val },
+		DefaultGraphQLMaxBatchSize,
+	); err != nil {
+		return err
+	}
 
 	if config.Raft, err = parseRAFTConfig(config.Cluster.Hostname); err != nil {
 		return fmt.Errorf("parse raft config: %w", err)
@@ -595,6 +605,41 @@ func FromEnv(config *Config) error {
 		return err
 	}
 
+	// DATA_MASKING_POLICIES_PATH points to a yaml file with a list of
+	// response-time masking policies, see masking.Config for the format
+	if v := os.Getenv("DATA_MASKING_POLICIES_PATH"); v != "" {
+		masks, err := masking.LoadConfig(v)
+		if err != nil {
+			return fmt.Errorf("parse DATA_MASKING_POLICIES_PATH: %w", err)
+		}
+		// merge into the existing settings, so that a hash_key from the main
+		// config file is kept if the policy file doesn't set one
+		config.DataMasking.Policies = masks.Policies
+		if masks.HashKey != "" {
+			config.DataMasking.HashKey = masks.HashKey
+		}
+	}
+
+	// DATA_MASKING_HASH_KEY is the secret the "hash" strategy is keyed with.
+	// It is validated together with the policies in Config.Validate.
+	if v := os.Getenv("DATA_MASKING_HASH_KEY"); v != "" {
+		config.DataMasking.HashKey = v
+	}
+
+	if err := parseUsageConfig(&config.Usage); err != nil {
+		return err
+	}
+
+	// QOS_CONFIG_PATH points to a yaml file with the QoS classes of
+	// principals, see qos.Config for the format
+	if v := os.Getenv("QOS_CONFIG_PATH"); v != "" {
+		qosConfig, err := qos.LoadConfig(v)
+		if err != nil {
+			return fmt.Errorf("parse QOS_CONFIG_PATH: %w", err)
+		}
+		config.QoS = qosConfig
+	}
+
 	config.RuntimeOverrides.Enabled = entcfg.Enabled(os.Getenv("RUNTIME_OVERRIDES_ENABLED"))
 
 	if v := os.Getenv("RUNTIME_OVERRIDES_PATH"); v != "" {
@@ -612,6 +657,52 @@ func FromEnv(config *Config) error {
 	return nil
 }
 
+// parseUsageConfig reads the usage reporting config. Environment variables
+// only override the fields they are set for, the rest of the usage section
+// of the config file is kept. Reporting is disabled unless a sink is set.
+func parseUsageConfig(cfg *usage.Config) error {
+	if v := os.Getenv("USAGE_SINK"); v != "" {
+		cfg.Sink = v
+	}
+
+	if v := os.Getenv("USAGE_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse USAGE_INTERVAL as time.Duration: %w", err)
+		}
+		cfg.Interval = interval
+	}
+	if cfg.Interval == 0 {
+		cfg.Interval = usage.DefaultInterval
+	}
+
+	if v := os.Getenv("USAGE_FILE_PATH"); v != "" {
+		cfg.FilePath = v
+	}
+
+	if v := os.Getenv("USAGE_S3_BUCKET"); v != "" {
+		cfg.S3Bucket = v
+	}
+	if v := os.Getenv("USAGE_S3_PATH"); v != "" {
+		cfg.S3Path = v
+	}
+	if v := os.Getenv("USAGE_S3_ENDPOINT"); v != "" {
+		cfg.S3Endpoint = v
+	}
+	if v := os.Getenv("USAGE_S3_USE_SSL"); v != "" {
+		useSSL := entcfg.Enabled(v)
+		cfg.S3UseSSL = &useSSL
+	}
+
+	if v := os.Getenv("USAGE_HTTP_URL"); v != "" {
+		cfg.HTTPURL = v
+	}
+	if v := os.Getenv("USAGE_HTTP_TOKEN"); v != "" {
+		cfg.HTTPToken = v
+	}
+	return nil
+}
+
 func parseRAFTConfig(hostname string) (Raft, error) {
 	// flag.IntVar()
 	cfg := Raft{
@@ -927,6 +1018,7 @@ const (
 	DefaultGRPCMaxMsgSize                      = 104858000 // 100 * 1024 * 1024 + 400
 	DefaultMinimumReplicationFactor            = 1
 	DefaultMaximumAllowedCollectionsCount      = -1 // unlimited
+	DefaultGraphQLMaxBatchSize                 = 100
 )
 
 const VectorizerModuleNone = "none"
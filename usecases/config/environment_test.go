@@ -15,11 +15,15 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/masking"
+	"github.com/weaviate/weaviate/usecases/usage"
 )
 
 const DefaultGoroutineFactor = 1.5
@@ -515,6 +519,36 @@ func TestEnvironmentDisableGraphQL(t *testing.T) {
 	}
 }
 
+func TestEnvironmentGraphQLMaxBatchSize(t *testing.T) {
+	factors := []struct {
+		name        string
+		value       []string
+		expected    int
+		expectedErr bool
+	}{
+		{"Valid", []string{"20"}, 20, false},
+		{"not given", []string{}, DefaultGraphQLMaxBatchSize, false},
+		{"invalid factor", []string{"-1"}, -1, true},
+		{"zero factor", []string{"0"}, -1, true},
+		{"not parsable", []string{"I'm not a number"}, -1, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("GRAPHQL_MAX_BATCH_SIZE", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Equal(t, tt.expected, conf.GraphQLMaxBatchSize)
+			}
+		})
+	}
+}
+
 func TestEnvironmentCORS_Headers(t *testing.T) {
 	factors := []struct {
 		name        string
@@ -908,6 +942,65 @@ func TestEnvironmentHNSWAcornFilterRatio(t *testing.T) {
 	}
 }
 
+func TestEnvironmentUsageConfig(t *testing.T) {
+	t.Run("keeps config file values", func(t *testing.T) {
+		useSSL := false
+		conf := Config{Usage: usage.Config{
+			Sink:       usage.SinkS3,
+			Interval:   10 * time.Minute,
+			S3Bucket:   "usage",
+			S3Endpoint: "minio:9000",
+			S3UseSSL:   &useSSL,
+		}}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, usage.SinkS3, conf.Usage.Sink)
+		assert.Equal(t, 10*time.Minute, conf.Usage.Interval)
+		assert.Equal(t, "usage", conf.Usage.S3Bucket)
+		assert.Equal(t, "minio:9000", conf.Usage.S3Endpoint)
+		require.NotNil(t, conf.Usage.S3UseSSL)
+		assert.False(t, *conf.Usage.S3UseSSL)
+	})
+
+	t.Run("env overrides set fields only", func(t *testing.T) {
+		t.Setenv("USAGE_S3_PATH", "reports")
+		t.Setenv("USAGE_INTERVAL", "5m")
+		conf := Config{Usage: usage.Config{Sink: usage.SinkS3, S3Bucket: "usage"}}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, usage.SinkS3, conf.Usage.Sink)
+		assert.Equal(t, "usage", conf.Usage.S3Bucket)
+		assert.Equal(t, "reports", conf.Usage.S3Path)
+		assert.Equal(t, 5*time.Minute, conf.Usage.Interval)
+		assert.Nil(t, conf.Usage.S3UseSSL)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.False(t, conf.Usage.Enabled())
+		assert.Equal(t, usage.DefaultInterval, conf.Usage.Interval)
+	})
+
+	t.Run("invalid interval", func(t *testing.T) {
+		t.Setenv("USAGE_INTERVAL", "often")
+		assert.NotNil(t, FromEnv(&Config{}))
+	})
+}
+
+func TestEnvironmentDataMaskingPolicies(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "masking.yaml")
+	require.Nil(t, os.WriteFile(path, []byte(`policies:
+- collection: Customer
+  property: email
+  strategy: hash
+`), 0o600))
+	t.Setenv("DATA_MASKING_POLICIES_PATH", path)
+
+	conf := Config{DataMasking: masking.Config{HashKey: "from-config-file"}}
+	require.Nil(t, FromEnv(&conf))
+	assert.Len(t, conf.DataMasking.Policies, 1)
+	assert.Equal(t, "from-config-file", conf.DataMasking.HashKey)
+}
+
 func TestEnabledForHost(t *testing.T) {
 	localHostname := "weaviate-1"
 	envName := "HOSTBASED_SETTING"
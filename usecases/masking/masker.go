@@ -0,0 +1,378 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package masking applies response-time masking policies to object
+// properties. Masking happens only when results are serialized for the
+// caller, the stored data as well as filters, sorting and search operate on
+// the original values.
+package masking
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+type roleGetter interface {
+	GetRoleNamesForPrincipal(principal *models.Principal) ([]string, error)
+}
+
+// Masker holds all configured policies and resolves them for a principal.
+// A nil Masker is valid and never masks anything.
+type Masker struct {
+	// map[collection]map[property][]Policy
+	policies map[string]map[string][]Policy
+	hashKey  []byte
+	roles    roleGetter
+	logger   logrus.FieldLogger
+}
+
+// New creates a Masker for the given config. The roles of a principal are
+// looked up through roles, which resolves them through RBAC if it is enabled.
+// If roles is nil, the groups of the principal are used as its roles.
+func New(cfg Config, roles roleGetter, logger logrus.FieldLogger) *Masker {
+	if len(cfg.Policies) == 0 {
+		return nil
+	}
+
+	policies := map[string]map[string][]Policy{}
+	for _, p := range cfg.Policies {
+		if _, ok := policies[p.Collection]; !ok {
+			policies[p.Collection] = map[string][]Policy{}
+		}
+		policies[p.Collection][p.Property] = append(policies[p.Collection][p.Property], p)
+	}
+
+	return &Masker{
+		policies: policies,
+		hashKey:  []byte(cfg.HashKey),
+		roles:    roles,
+		logger:   logger,
+	}
+}
+
+// ForPrincipal returns the Rules that apply to the given principal. The
+// returned Rules may be nil if nothing needs to be masked.
+//
+// A nil principal is an anonymous request. Anonymous callers hold no role
+// that could exempt them from a policy, so like principals whose roles can't
+// be resolved they are masked with every policy that exists for a property.
+func (m *Masker) ForPrincipal(principal *models.Principal) *Rules {
+	if m == nil {
+		return nil
+	}
+
+	allPolicies := principal == nil
+	roles, err := m.principalRoles(principal)
+	if err != nil {
+		// fail closed: if we cannot tell which roles the principal holds, we
+		// mask with every policy that exists for a property
+		m.logger.WithField("action", "data_masking").WithError(err).
+			Warn("could not resolve roles of principal, applying all masking policies")
+		allPolicies = true
+	}
+
+	byCollection := map[string]map[string]Policy{}
+	for collection, props := range m.policies {
+		for prop, policies := range props {
+			for _, p := range policies {
+				if !allPolicies && !p.appliesTo(roles) {
+					continue
+				}
+
+				if _, ok := byCollection[collection]; !ok {
+					byCollection[collection] = map[string]Policy{}
+				}
+				existing, ok := byCollection[collection][prop]
+				if !ok || rank(p.Strategy) > rank(existing.Strategy) {
+					byCollection[collection][prop] = p
+				}
+			}
+		}
+	}
+
+	if len(byCollection) == 0 {
+		return nil
+	}
+	return &Rules{byCollection: byCollection, hashKey: m.hashKey}
+}
+
+func (m *Masker) principalRoles(principal *models.Principal) ([]string, error) {
+	if principal == nil {
+		return nil, nil
+	}
+
+	if m.roles == nil {
+		return principal.Groups, nil
+	}
+
+	// roles are resolved like permissions, including those granted to the
+	// groups of the principal
+	roles, err := m.roles.GetRoleNamesForPrincipal(principal)
+	if err != nil {
+		return nil, fmt.Errorf("get roles for user %q: %w", principal.Username, err)
+	}
+	return roles, nil
+}
+
+func (p Policy) appliesTo(roles []string) bool {
+	if len(p.Roles) == 0 {
+		return true
+	}
+	for _, role := range p.Roles {
+		if slices.Contains(roles, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// rank orders strategies by how much they reveal, so that the most
+// restrictive one wins if several policies match the same property
+func rank(s Strategy) int {
+	switch s {
+	case StrategyNull:
+		return 3
+	case StrategyHash:
+		return 2
+	case StrategyPartial:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Rules are the masking policies resolved for a single principal. A nil
+// Rules is valid and does not mask anything.
+type Rules struct {
+	// map[collection]map[property]Policy
+	byCollection map[string]map[string]Policy
+	hashKey      []byte
+}
+
+// CheckAggregate returns an error if any of the given properties of a
+// collection is masked. Aggregations such as topOccurrences would otherwise
+// return the original values of a masked property.
+func (r *Rules) CheckAggregate(className string, properties []string) error {
+	if r == nil {
+		return nil
+	}
+
+	policies := r.byCollection[className]
+	for _, prop := range properties {
+		if _, ok := policies[prop]; ok {
+			return fmt.Errorf("cannot aggregate masked property %q of collection %q", prop, className)
+		}
+	}
+	return nil
+}
+
+// CheckModuleAdditional returns an error if modules are asked to compute
+// additional properties, such as generated text or rerank scores, for a
+// collection with masked properties. Modules read the original values, so
+// their output could reveal what is masked.
+func (r *Rules) CheckModuleAdditional(className string, moduleParams map[string]interface{}) error {
+	if r == nil || len(moduleParams) == 0 || len(r.byCollection[className]) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(moduleParams))
+	for name := range moduleParams {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return fmt.Errorf("cannot use %s on collection %q, it has masked properties",
+		strings.Join(names, ", "), className)
+}
+
+// MaskObject masks the properties of an object in place
+func (r *Rules) MaskObject(obj *models.Object) {
+	if r == nil || obj == nil {
+		return
+	}
+
+	if props, ok := obj.Properties.(map[string]interface{}); ok {
+		r.MaskProperties(obj.Class, props)
+	}
+}
+
+// MaskObjects masks the properties of all objects in place
+func (r *Rules) MaskObjects(objs []*models.Object) {
+	if r == nil {
+		return
+	}
+
+	for _, obj := range objs {
+		r.MaskObject(obj)
+	}
+}
+
+// MaskGetResults masks the results of a Get query in place. Results are
+// expected in the shape produced by the explorer, i.e. one property map per
+// hit, with resolved references as search.LocalRef and grouped hits as part
+// of the "_additional" map.
+func (r *Rules) MaskGetResults(className string, results []interface{}) {
+	if r == nil {
+		return
+	}
+
+	for _, res := range results {
+		if props, ok := res.(map[string]interface{}); ok {
+			r.MaskProperties(className, props)
+		}
+	}
+}
+
+// MaskProperties masks a property map of the given collection in place.
+// Resolved references are masked according to the policies of their target
+// collection.
+func (r *Rules) MaskProperties(className string, props map[string]interface{}) {
+	if r == nil || props == nil {
+		return
+	}
+
+	policies := r.byCollection[className]
+	for name, value := range props {
+		if name == "_additional" {
+			r.maskAdditional(className, policies, value)
+			continue
+		}
+
+		if p, ok := policies[name]; ok {
+			if masked, keep := p.mask(r.hashKey, value); keep {
+				props[name] = masked
+			} else {
+				delete(props, name)
+			}
+			continue
+		}
+
+		r.maskRefs(value)
+	}
+}
+
+func (r *Rules) maskAdditional(className string, policies map[string]Policy, value interface{}) {
+	additionalProps, ok := value.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	group, ok := additionalProps["group"].(*additional.Group)
+	if !ok || group == nil {
+		return
+	}
+
+	for _, hit := range group.Hits {
+		r.MaskProperties(className, hit)
+	}
+
+	// the value a group was formed by would otherwise reveal the original
+	// value of a masked property
+	if group.GroupedBy != nil && len(group.GroupedBy.Path) > 0 {
+		if p, ok := policies[group.GroupedBy.Path[0]]; ok {
+			masked, keep := p.mask(r.hashKey, group.GroupedBy.Value)
+			if s, isString := masked.(string); keep && isString {
+				group.GroupedBy.Value = s
+			} else {
+				group.GroupedBy.Value = ""
+			}
+		}
+	}
+}
+
+func (r *Rules) maskRefs(value interface{}) {
+	refs, ok := value.([]interface{})
+	if !ok {
+		return
+	}
+
+	for _, ref := range refs {
+		if local, ok := ref.(search.LocalRef); ok {
+			r.MaskProperties(local.Class, local.Fields)
+		}
+	}
+}
+
+// mask returns the masked value and whether the property should be kept in
+// the response at all. Only text values can be hashed or partially redacted,
+// all other data types are removed, so that the response stays valid for the
+// declared data type of the property.
+func (p Policy) mask(hashKey []byte, value interface{}) (interface{}, bool) {
+	if p.Strategy == StrategyNull || value == nil {
+		return nil, false
+	}
+
+	switch v := value.(type) {
+	case string:
+		return p.maskString(hashKey, v), true
+	case []string:
+		out := make([]string, len(v))
+		for i := range v {
+			out[i] = p.maskString(hashKey, v[i])
+		}
+		return out, true
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i := range v {
+			s, ok := v[i].(string)
+			if !ok {
+				return nil, false
+			}
+			out[i] = p.maskString(hashKey, s)
+		}
+		return out, true
+	default:
+		return nil, false
+	}
+}
+
+func (p Policy) maskString(hashKey []byte, s string) string {
+	switch p.Strategy {
+	case StrategyHash:
+		// keyed, so that low-entropy values such as emails or SSNs can't be
+		// recovered by hashing candidates
+		mac := hmac.New(sha256.New, hashKey)
+		mac.Write([]byte(s))
+		return hex.EncodeToString(mac.Sum(nil))
+	case StrategyPartial:
+		return redact(s, p.KeepFirst, p.KeepLast)
+	default:
+		return ""
+	}
+}
+
+// redact replaces all but the first keepFirst and last keepLast characters
+// with '*'. If s looks like an email address, only the local part is
+// redacted. Values too short to hide anything are redacted completely.
+func redact(s string, keepFirst, keepLast int) string {
+	if at := strings.LastIndex(s, "@"); at > 0 {
+		return redact(s[:at], keepFirst, keepLast) + s[at:]
+	}
+
+	runes := []rune(s)
+	if keepFirst+keepLast >= len(runes) {
+		return strings.Repeat("*", len(runes))
+	}
+
+	for i := keepFirst; i < len(runes)-keepLast; i++ {
+		runes[i] = '*'
+	}
+	return string(runes)
+}
@@ -0,0 +1,299 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package masking
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+type fakeRoles struct {
+	roles      map[string][]string
+	groupRoles map[string][]string
+	err        error
+}
+
+func (f *fakeRoles) GetRoleNamesForPrincipal(principal *models.Principal) ([]string, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	out := append([]string{}, f.roles[principal.Username]...)
+	for _, group := range principal.Groups {
+		out = append(out, f.groupRoles[group]...)
+	}
+	return out, nil
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		in        string
+		keepFirst int
+		keepLast  int
+		expected  string
+	}{
+		{in: "john.doe@example.com", keepFirst: 1, expected: "j*******@example.com"},
+		{in: "secret", keepFirst: 1, keepLast: 1, expected: "s****t"},
+		{in: "ab", keepFirst: 1, keepLast: 1, expected: "**"},
+		{in: "Łódź", keepFirst: 1, expected: "Ł***"},
+		{in: "4111111111111111", keepLast: 4, expected: "************1111"},
+		{in: "", keepFirst: 1, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.expected, redact(tt.in, tt.keepFirst, tt.keepLast))
+		})
+	}
+}
+
+func TestMaskerForPrincipal(t *testing.T) {
+	cfg := Config{Policies: []Policy{
+		{Collection: "Customer", Property: "email", Strategy: StrategyPartial, KeepFirst: 1, Roles: []string{"support"}},
+		{Collection: "Customer", Property: "email", Strategy: StrategyNull, Roles: []string{"intern"}},
+		{Collection: "Customer", Property: "ssn", Strategy: StrategyNull},
+	}}
+	roles := &fakeRoles{roles: map[string][]string{
+		"support-user": {"support"},
+		"intern-user":  {"support", "intern"},
+		"admin-user":   {"admin"},
+	}, groupRoles: map[string][]string{
+		"interns": {"intern"},
+	}}
+	logger, _ := test.NewNullLogger()
+	masker := New(cfg, roles, logger)
+
+	obj := func() *models.Object {
+		return &models.Object{
+			Class: "Customer",
+			Properties: map[string]interface{}{
+				"email": "john@example.com",
+				"ssn":   "123-45-6789",
+				"name":  "John",
+			},
+		}
+	}
+
+	t.Run("policy without roles applies to everybody", func(t *testing.T) {
+		o := obj()
+		masker.ForPrincipal(&models.Principal{Username: "admin-user"}).MaskObject(o)
+		assert.Equal(t, map[string]interface{}{"email": "john@example.com", "name": "John"}, o.Properties)
+	})
+
+	t.Run("anonymous principal fails closed", func(t *testing.T) {
+		o := obj()
+		masker.ForPrincipal(nil).MaskObject(o)
+		assert.Equal(t, map[string]interface{}{"name": "John"}, o.Properties)
+	})
+
+	t.Run("role specific policy", func(t *testing.T) {
+		o := obj()
+		masker.ForPrincipal(&models.Principal{Username: "support-user"}).MaskObject(o)
+		assert.Equal(t, map[string]interface{}{"email": "j***@example.com", "name": "John"}, o.Properties)
+	})
+
+	t.Run("role held through a group", func(t *testing.T) {
+		o := obj()
+		masker.ForPrincipal(&models.Principal{Username: "oidc-user", Groups: []string{"interns"}}).MaskObject(o)
+		assert.Equal(t, map[string]interface{}{"name": "John"}, o.Properties)
+	})
+
+	t.Run("most restrictive policy wins", func(t *testing.T) {
+		o := obj()
+		masker.ForPrincipal(&models.Principal{Username: "intern-user"}).MaskObject(o)
+		assert.Equal(t, map[string]interface{}{"name": "John"}, o.Properties)
+	})
+
+	t.Run("other collections are untouched", func(t *testing.T) {
+		o := obj()
+		o.Class = "Order"
+		masker.ForPrincipal(&models.Principal{Username: "intern-user"}).MaskObject(o)
+		assert.Len(t, o.Properties, 3)
+	})
+
+	t.Run("role lookup fails closed", func(t *testing.T) {
+		failing := New(cfg, &fakeRoles{err: errors.New("boom")}, logger)
+		o := obj()
+		failing.ForPrincipal(&models.Principal{Username: "admin-user"}).MaskObject(o)
+		assert.Equal(t, map[string]interface{}{"name": "John"}, o.Properties)
+	})
+
+	t.Run("groups are used as roles without rbac", func(t *testing.T) {
+		noRBAC := New(cfg, nil, logger)
+		o := obj()
+		noRBAC.ForPrincipal(&models.Principal{Username: "someone", Groups: []string{"support"}}).MaskObject(o)
+		assert.Equal(t, "j***@example.com", o.Properties.(map[string]interface{})["email"])
+	})
+
+	t.Run("nil masker does nothing", func(t *testing.T) {
+		var m *Masker
+		o := obj()
+		m.ForPrincipal(&models.Principal{Username: "intern-user"}).MaskObject(o)
+		assert.Len(t, o.Properties, 3)
+	})
+}
+
+func TestMaskGetResults(t *testing.T) {
+	cfg := Config{Policies: []Policy{
+		{Collection: "Customer", Property: "email", Strategy: StrategyHash},
+		{Collection: "Customer", Property: "age", Strategy: StrategyHash},
+		{Collection: "Address", Property: "street", Strategy: StrategyPartial, KeepFirst: 2},
+	}, HashKey: "secret"}
+	logger, _ := test.NewNullLogger()
+	rules := New(cfg, nil, logger).ForPrincipal(nil)
+	require.NotNil(t, rules)
+
+	group := &additional.Group{
+		GroupedBy: &additional.GroupedBy{Value: "a@b.c", Path: []string{"email"}},
+		Hits:      []map[string]interface{}{{"email": "a@b.c"}},
+	}
+	results := []interface{}{
+		map[string]interface{}{
+			"email":   "a@b.c",
+			"aliases": []string{"x"},
+			"age":     42.0,
+			"livesAt": []interface{}{
+				search.LocalRef{Class: "Address", Fields: map[string]interface{}{"street": "Main Street"}},
+			},
+			"_additional": map[string]interface{}{"group": group},
+		},
+	}
+
+	rules.MaskGetResults("Customer", results)
+
+	props := results[0].(map[string]interface{})
+	// HMAC-SHA256 of "a@b.c" keyed with "secret"
+	hashed := "0ce3629b4ac1ef1367b15f9d7659135a1c8663659b98cfd72c175d86612f7879"
+	assert.Equal(t, hashed, props["email"])
+	assert.NotContains(t, props, "age", "non-text values can't be hashed and are removed")
+	assert.Equal(t, []string{"x"}, props["aliases"])
+	ref := props["livesAt"].([]interface{})[0].(search.LocalRef)
+	assert.Equal(t, "Ma*********", ref.Fields["street"])
+	assert.Equal(t, hashed, group.Hits[0]["email"])
+	assert.Equal(t, hashed, group.GroupedBy.Value)
+}
+
+func TestCheckAggregate(t *testing.T) {
+	cfg := Config{Policies: []Policy{
+		{Collection: "Customer", Property: "email", Strategy: StrategyPartial, Roles: []string{"support"}},
+	}}
+	roles := &fakeRoles{roles: map[string][]string{"support-user": {"support"}}}
+	logger, _ := test.NewNullLogger()
+	masker := New(cfg, roles, logger)
+
+	rules := masker.ForPrincipal(&models.Principal{Username: "support-user"})
+	assert.ErrorContains(t, rules.CheckAggregate("Customer", []string{"name", "email"}), "masked property")
+	assert.Nil(t, rules.CheckAggregate("Customer", []string{"name"}))
+	assert.Nil(t, rules.CheckAggregate("Order", []string{"email"}))
+
+	assert.Error(t, masker.ForPrincipal(nil).CheckAggregate("Customer", []string{"email"}),
+		"anonymous callers are masked with every policy")
+	assert.Nil(t, masker.ForPrincipal(&models.Principal{Username: "admin-user"}).
+		CheckAggregate("Customer", []string{"email"}))
+}
+
+func TestCheckModuleAdditional(t *testing.T) {
+	cfg := Config{Policies: []Policy{
+		{Collection: "Customer", Property: "email", Strategy: StrategyHash, Roles: []string{"support"}},
+	}}
+	roles := &fakeRoles{roles: map[string][]string{"support-user": {"support"}}}
+	logger, _ := test.NewNullLogger()
+	masker := New(cfg, roles, logger)
+	moduleParams := map[string]interface{}{"rerank": nil, "generate": nil}
+
+	rules := masker.ForPrincipal(&models.Principal{Username: "support-user"})
+	assert.ErrorContains(t, rules.CheckModuleAdditional("Customer", moduleParams),
+		"cannot use generate, rerank on collection \"Customer\"")
+	assert.Nil(t, rules.CheckModuleAdditional("Customer", nil))
+	assert.Nil(t, rules.CheckModuleAdditional("Order", moduleParams))
+	assert.Nil(t, masker.ForPrincipal(&models.Principal{Username: "admin-user"}).
+		CheckModuleAdditional("Customer", moduleParams))
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("valid", func(t *testing.T) {
+		path := filepath.Join(dir, "valid.yaml")
+		require.Nil(t, os.WriteFile(path, []byte(`
+policies:
+- collection: Customer
+  property: email
+  strategy: partial
+  keep_first: 1
+  roles: [support]
+`), 0o600))
+
+		cfg, err := LoadConfig(path)
+		require.Nil(t, err)
+		assert.Equal(t, []Policy{{
+			Collection: "Customer", Property: "email", Strategy: StrategyPartial,
+			KeepFirst: 1, Roles: []string{"support"},
+		}}, cfg.Policies)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		path := filepath.Join(dir, "empty.yaml")
+		require.Nil(t, os.WriteFile(path, nil, 0o600))
+
+		cfg, err := LoadConfig(path)
+		require.Nil(t, err)
+		assert.Empty(t, cfg.Policies)
+	})
+
+	t.Run("invalid strategy", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.yaml")
+		require.Nil(t, os.WriteFile(path, []byte(`
+policies:
+- collection: Customer
+  property: email
+  strategy: scramble
+`), 0o600))
+
+		_, err := LoadConfig(path)
+		assert.ErrorContains(t, err, "unsupported strategy")
+	})
+
+	t.Run("duplicate policy", func(t *testing.T) {
+		cfg := Config{Policies: []Policy{
+			{Collection: "Customer", Property: "email", Strategy: StrategyHash},
+			{Collection: "Customer", Property: "email", Strategy: StrategyNull},
+		}, HashKey: "secret"}
+		assert.ErrorContains(t, cfg.Validate(), "duplicate policy")
+	})
+
+	t.Run("hash strategy requires a key", func(t *testing.T) {
+		path := filepath.Join(dir, "hash.yaml")
+		require.Nil(t, os.WriteFile(path, []byte(`
+policies:
+- collection: Customer
+  property: ssn
+  strategy: hash
+`), 0o600))
+
+		cfg, err := LoadConfig(path)
+		require.Nil(t, err, "the key may be set through the environment")
+		assert.ErrorContains(t, cfg.Validate(), "requires a hash_key")
+
+		cfg.HashKey = "secret"
+		assert.Nil(t, cfg.Validate())
+	})
+}
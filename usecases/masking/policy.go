@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package masking
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Strategy describes how a masked property value is rendered in a response
+type Strategy string
+
+const (
+	// StrategyHash replaces the value with the hex encoded HMAC-SHA256 of its
+	// string representation, keyed with Config.HashKey. Equal values stay
+	// equal, so masked values can still be compared by clients.
+	StrategyHash Strategy = "hash"
+	// StrategyPartial replaces all but the first and last few characters of
+	// the value with '*'. For values that look like an email address only the
+	// local part is redacted.
+	StrategyPartial Strategy = "partial"
+	// StrategyNull removes the property from the response entirely.
+	StrategyNull Strategy = "null"
+)
+
+// Policy attaches a masking Strategy to a single property of a collection.
+// If Roles is empty the policy applies to every principal, otherwise only to
+// principals that hold at least one of the listed roles.
+type Policy struct {
+	Collection string   `json:"collection" yaml:"collection"`
+	Property   string   `json:"property" yaml:"property"`
+	Strategy   Strategy `json:"strategy" yaml:"strategy"`
+	Roles      []string `json:"roles" yaml:"roles"`
+
+	// KeepFirst and KeepLast control how many characters are left untouched
+	// by StrategyPartial. They are ignored for all other strategies.
+	KeepFirst int `json:"keep_first" yaml:"keep_first"`
+	KeepLast  int `json:"keep_last" yaml:"keep_last"`
+}
+
+func (p Policy) Validate() error {
+	if p.Collection == "" {
+		return fmt.Errorf("collection must be set")
+	}
+	if p.Property == "" {
+		return fmt.Errorf("property must be set")
+	}
+
+	switch p.Strategy {
+	case StrategyHash, StrategyPartial, StrategyNull:
+	default:
+		return fmt.Errorf("unsupported strategy %q, must be one of [%q, %q, %q]",
+			p.Strategy, StrategyHash, StrategyPartial, StrategyNull)
+	}
+
+	if p.KeepFirst < 0 || p.KeepLast < 0 {
+		return fmt.Errorf("keep_first and keep_last must not be negative")
+	}
+
+	return nil
+}
+
+// Config is the set of masking policies active on this node
+type Config struct {
+	Policies []Policy `json:"policies" yaml:"policies"`
+
+	// HashKey is the server-side secret used by StrategyHash. It is required
+	// as soon as a policy uses that strategy.
+	HashKey string `json:"hash_key" yaml:"hash_key"`
+}
+
+func (c Config) Validate() error {
+	if err := c.validatePolicies(); err != nil {
+		return err
+	}
+
+	for i, p := range c.Policies {
+		if p.Strategy == StrategyHash && c.HashKey == "" {
+			return fmt.Errorf("data_masking.policies[%d]: strategy %q requires a hash_key",
+				i, StrategyHash)
+		}
+	}
+
+	return nil
+}
+
+func (c Config) validatePolicies() error {
+	seen := map[string]struct{}{}
+	for i, p := range c.Policies {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("data_masking.policies[%d]: %w", i, err)
+		}
+
+		// the same property may be masked differently for different roles, but
+		// two policies for the same set of roles would be ambiguous
+		key := fmt.Sprintf("%s/%s/%v", p.Collection, p.Property, p.Roles)
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("data_masking.policies[%d]: duplicate policy for %s.%s",
+				i, p.Collection, p.Property)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// LoadConfig reads masking policies from a yaml file. The hash key may be
+// set separately, so it is only checked by Config.Validate.
+func LoadConfig(path string) (Config, error) {
+	var cfg Config
+
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, fmt.Errorf("read masking policies: %w", err)
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(buf))
+	dec.SetStrict(true)
+	// an empty file is valid and simply does not mask anything
+	if err := dec.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+		return cfg, fmt.Errorf("parse masking policies: %w", err)
+	}
+
+	return cfg, cfg.validatePolicies()
+}
@@ -0,0 +1,170 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package offload contains the parts of the tenant offload modules that do
+// not depend on a specific cloud provider: the object layout in the bucket,
+// transferring shard directories file by file and the upload, download and
+// delete operations on top of a provider specific Client.
+package offload
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// Status labels of the tenant offload operation metrics
+const (
+	StatusSuccess = "success"
+	StatusFailed  = "failed"
+)
+
+// Validate checks the arguments of an upload or download
+func Validate(className, shardName, nodeName string) error {
+	if className == "" {
+		return fmt.Errorf("can't pass empty class name")
+	}
+
+	if shardName == "" {
+		return fmt.Errorf("can't pass empty tenant name")
+	}
+
+	if nodeName == "" {
+		return fmt.Errorf("can't pass empty node name")
+	}
+
+	return nil
+}
+
+// ValidateDelete checks the arguments of a delete. Shard and node name may
+// both be empty to delete all frozen shards of a class.
+func ValidateDelete(className, shardName, nodeName string) error {
+	if className == "" {
+		return fmt.Errorf("can't pass empty class name")
+	}
+
+	if shardName == "" && nodeName != "" {
+		return fmt.Errorf("can't pass empty shard name")
+	}
+
+	if nodeName == "" && shardName != "" {
+		return fmt.Errorf("can't pass empty node name")
+	}
+
+	return nil
+}
+
+// LocalPath is the directory of a shard on disk:
+// {dataPath}/{className}/{shardName}
+func LocalPath(dataPath, className, shardName string) string {
+	return fmt.Sprintf("%s/%s/%s", dataPath, strings.ToLower(className), shardName)
+}
+
+// Prefix is the common prefix of all objects of a shard in the bucket:
+// {className}/{shardName}/{nodeName}/
+// If shard and node name are empty, it is the prefix of the whole class.
+func Prefix(className, shardName, nodeName string) string {
+	if shardName == "" && nodeName == "" {
+		return strings.ToLower(className) + "/"
+	}
+	return fmt.Sprintf("%s/%s/%s/", strings.ToLower(className), shardName, nodeName)
+}
+
+// UploadFunc stores the content of a single file under the object name
+type UploadFunc func(ctx context.Context, objectName string, r io.Reader) error
+
+// UploadDir uploads all files below localPath with the given concurrency. The
+// object names are the file paths relative to localPath appended to prefix.
+// It returns the number of bytes uploaded.
+func UploadDir(ctx context.Context, logger logrus.FieldLogger, localPath, prefix string,
+	concurrency int, upload UploadFunc,
+) (int64, error) {
+	var files []string
+	var size int64
+	err := filepath.Walk(localPath, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			files = append(files, p)
+			size += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("list files of %s: %w", localPath, err)
+	}
+
+	eg, ctx := enterrors.NewErrorGroupWithContextWrapper(logger, ctx)
+	if concurrency > 0 {
+		eg.SetLimit(concurrency)
+	}
+	for _, file := range files {
+		eg.Go(func() error {
+			rel, err := filepath.Rel(localPath, file)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Open(file)
+			if err != nil {
+				return fmt.Errorf("open %s: %w", file, err)
+			}
+			defer f.Close()
+
+			objectName := prefix + filepath.ToSlash(rel)
+			if err := upload(ctx, objectName, f); err != nil {
+				return fmt.Errorf("upload %s: %w", objectName, err)
+			}
+			return nil
+		}, file)
+	}
+	if err := eg.Wait(); err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// WriteFile writes the content of the object to its file below localPath.
+// The object name is relative to the prefix the shard was uploaded with.
+// It returns the number of bytes written.
+func WriteFile(localPath, relName string, r io.Reader) (int64, error) {
+	clean := path.Clean("/" + relName)
+	if clean == "/" {
+		return 0, fmt.Errorf("invalid object name %q", relName)
+	}
+
+	file := filepath.Join(localPath, filepath.FromSlash(clean))
+	if err := os.MkdirAll(filepath.Dir(file), os.ModePerm); err != nil {
+		return 0, fmt.Errorf("create directory for %s: %w", file, err)
+	}
+
+	f, err := os.Create(file)
+	if err != nil {
+		return 0, fmt.Errorf("create %s: %w", file, err)
+	}
+	defer f.Close()
+
+	n, err := io.Copy(f, r)
+	if err != nil {
+		return n, fmt.Errorf("write %s: %w", file, err)
+	}
+	return n, f.Sync()
+}
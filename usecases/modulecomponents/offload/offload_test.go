@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package offload
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefix(t *testing.T) {
+	assert.Equal(t, "myclass/tenant1/node1/", Prefix("MyClass", "tenant1", "node1"))
+	assert.Equal(t, "myclass/", Prefix("MyClass", "", ""))
+	assert.Equal(t, "/data/myclass/tenant1", LocalPath("/data", "MyClass", "tenant1"))
+}
+
+func TestValidate(t *testing.T) {
+	assert.Nil(t, Validate("C", "s", "n"))
+	assert.NotNil(t, Validate("", "s", "n"))
+	assert.NotNil(t, Validate("C", "", "n"))
+	assert.NotNil(t, Validate("C", "s", ""))
+
+	assert.Nil(t, ValidateDelete("C", "", ""))
+	assert.Nil(t, ValidateDelete("C", "s", "n"))
+	assert.NotNil(t, ValidateDelete("", "", ""))
+	assert.NotNil(t, ValidateDelete("C", "", "n"))
+	assert.NotNil(t, ValidateDelete("C", "s", ""))
+}
+
+func TestUploadAndWriteRoundTrip(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	src := t.TempDir()
+	files := map[string]string{
+		"indexcount":           "1",
+		"lsm/objects/seg.db":   "segment",
+		"lsm/property_name/wl": "wal",
+	}
+	for name, content := range files {
+		path := filepath.Join(src, filepath.FromSlash(name))
+		require.Nil(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+		require.Nil(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	var lock sync.Mutex
+	bucket := map[string][]byte{}
+	size, err := UploadDir(context.Background(), logger, src, "c/s/n/", 2,
+		func(ctx context.Context, objectName string, r io.Reader) error {
+			content, err := io.ReadAll(r)
+			if err != nil {
+				return err
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			bucket[objectName] = content
+			return nil
+		})
+	require.Nil(t, err)
+	assert.Equal(t, int64(len("1")+len("segment")+len("wal")), size)
+	assert.Len(t, bucket, 3)
+	assert.Equal(t, []byte("segment"), bucket["c/s/n/lsm/objects/seg.db"])
+
+	dst := t.TempDir()
+	for name, content := range bucket {
+		_, err := WriteFile(dst, name[len("c/s/n/"):], bytes.NewReader(content))
+		require.Nil(t, err)
+	}
+	for name, content := range files {
+		got, err := os.ReadFile(filepath.Join(dst, filepath.FromSlash(name)))
+		require.Nil(t, err)
+		assert.Equal(t, content, string(got))
+	}
+
+	t.Run("upload error", func(t *testing.T) {
+		_, err := UploadDir(context.Background(), logger, src, "", 0,
+			func(ctx context.Context, objectName string, r io.Reader) error {
+				return errors.New("denied")
+			})
+		assert.ErrorContains(t, err, "denied")
+	})
+}
+
+func TestWriteFileStaysInLocalPath(t *testing.T) {
+	dst := t.TempDir()
+	_, err := WriteFile(dst, "../../escape", bytes.NewReader([]byte("x")))
+	require.Nil(t, err)
+	_, err = os.Stat(filepath.Join(dst, "escape"))
+	assert.Nil(t, err)
+
+	_, err = WriteFile(dst, "", bytes.NewReader(nil))
+	assert.NotNil(t, err)
+}
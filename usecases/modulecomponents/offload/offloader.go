@@ -0,0 +1,217 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package offload
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+const (
+	dataPathEnv = "PERSISTENCE_DATA_PATH"
+	timeoutEnv  = "OFFLOAD_TIMEOUT"
+)
+
+// Client is the provider specific part of an offload module. Object names
+// are relative to the bucket or container of the client.
+type Client interface {
+	// Upload stores the content of a single object
+	Upload(ctx context.Context, objectName string, r io.Reader) error
+	// Download returns the content of a single object
+	Download(ctx context.Context, objectName string) (io.ReadCloser, error)
+	// Delete removes a single object, an object that does not exist is not
+	// an error
+	Delete(ctx context.Context, objectName string) error
+	// List calls fn with the name of every object with the given prefix
+	List(ctx context.Context, prefix string, fn func(objectName string)) error
+}
+
+// Config is the provider independent configuration of an offload module
+type Config struct {
+	DataPath    string
+	Concurrency int
+	Timeout     time.Duration
+}
+
+// FromEnv overrides the configuration with the values set in the
+// environment. The concurrency is read from the provider specific variable.
+func (c *Config) FromEnv(concurrencyEnv string) error {
+	if path := os.Getenv(dataPathEnv); path != "" {
+		c.DataPath = path
+	}
+
+	if eTimeout := os.Getenv(timeoutEnv); eTimeout != "" {
+		timeoutN, err := time.ParseDuration(fmt.Sprintf("%ss", eTimeout))
+		if err != nil {
+			return err
+		}
+		c.Timeout = time.Duration(timeoutN.Seconds()) * time.Second
+	}
+
+	if concc := os.Getenv(concurrencyEnv); concc != "" {
+		conccN, err := strconv.Atoi(concc)
+		if err != nil {
+			return err
+		}
+		c.Concurrency = conccN
+	}
+
+	return nil
+}
+
+// Offloader transfers shards between disk and a bucket through a Client and
+// records the tenant offload metrics
+type Offloader struct {
+	config  Config
+	client  Client
+	logger  logrus.FieldLogger
+	metrics *monitoring.TenantOffloadMetrics
+}
+
+func New(config Config, client Client, logger logrus.FieldLogger,
+	metrics *monitoring.TenantOffloadMetrics,
+) *Offloader {
+	return &Offloader{
+		config:  config,
+		client:  client,
+		logger:  logger,
+		metrics: metrics,
+	}
+}
+
+// Upload uploads the content of a shard assigned to specific node to
+// cloud provider (S3, Azure Blob storage, Google cloud storage)
+// {cloud_provider}://{configured_bucket}/{className}/{shardName}/{nodeName}/{shard content}
+func (o *Offloader) Upload(ctx context.Context, className, shardName, nodeName string) error {
+	start := time.Now()
+
+	if err := Validate(className, shardName, nodeName); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, o.config.Timeout)
+	defer cancel()
+
+	size, err := UploadDir(ctx, o.logger,
+		LocalPath(o.config.DataPath, className, shardName),
+		Prefix(className, shardName, nodeName), o.config.Concurrency, o.client.Upload)
+
+	o.metrics.TransferredBytes.Add(float64(size))
+	o.observe("upload", start, err)
+	return err
+}
+
+// Download downloads the content of a shard to desired node from
+// cloud provider (S3, Azure Blob storage, Google cloud storage)
+// {dataPath}/{className}/{shardName}/{content}
+func (o *Offloader) Download(ctx context.Context, className, shardName, nodeName string) error {
+	localPath := LocalPath(o.config.DataPath, className, shardName)
+	return o.DownloadToPath(ctx, className, shardName, nodeName, localPath)
+}
+
+func (o *Offloader) DownloadToPath(ctx context.Context, className, shardName, nodeName, localPath string) error {
+	start := time.Now()
+
+	if err := Validate(className, shardName, nodeName); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, o.config.Timeout)
+	defer cancel()
+
+	var size atomic.Int64
+	prefix := Prefix(className, shardName, nodeName)
+	err := o.forEachObject(ctx, prefix, func(ctx context.Context, objectName string) error {
+		r, err := o.client.Download(ctx, objectName)
+		if err != nil {
+			return fmt.Errorf("download %s: %w", objectName, err)
+		}
+		defer r.Close()
+
+		n, err := WriteFile(localPath, strings.TrimPrefix(objectName, prefix), r)
+		size.Add(n)
+		return err
+	})
+
+	o.metrics.FetchedBytes.Add(float64(size.Load()))
+	o.observe("download", start, err)
+	return err
+}
+
+// Delete deletes content of a shard assigned to specific node in
+// cloud provider (S3, Azure Blob storage, Google cloud storage)
+// Careful: if shardName and nodeName is passed empty it will delete all class frozen shards in cloud storage
+// {cloud_provider}://{configured_bucket}/{className}/{shardName}/{nodeName}/{shard content}
+func (o *Offloader) Delete(ctx context.Context, className, shardName, nodeName string) error {
+	start := time.Now()
+
+	if err := ValidateDelete(className, shardName, nodeName); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, o.config.Timeout)
+	defer cancel()
+
+	err := o.forEachObject(ctx, Prefix(className, shardName, nodeName),
+		func(ctx context.Context, objectName string) error {
+			if err := o.client.Delete(ctx, objectName); err != nil {
+				return fmt.Errorf("delete %s: %w", objectName, err)
+			}
+			return nil
+		})
+
+	o.observe("delete", start, err)
+	return err
+}
+
+// forEachObject calls fn concurrently for all objects with the given prefix
+func (o *Offloader) forEachObject(ctx context.Context, prefix string,
+	fn func(ctx context.Context, objectName string) error,
+) error {
+	eg, ctx := enterrors.NewErrorGroupWithContextWrapper(o.logger, ctx)
+	if o.config.Concurrency > 0 {
+		eg.SetLimit(o.config.Concurrency)
+	}
+
+	err := o.client.List(ctx, prefix, func(objectName string) {
+		eg.Go(func() error {
+			return fn(ctx, objectName)
+		}, objectName)
+	})
+	if err != nil {
+		// a failed operation cancels the listing, report its error instead
+		if opErr := eg.Wait(); opErr != nil {
+			return opErr
+		}
+		return fmt.Errorf("list objects %s: %w", prefix, err)
+	}
+	return eg.Wait()
+}
+
+func (o *Offloader) observe(op string, start time.Time, err error) {
+	status := StatusSuccess
+	if err != nil {
+		status = StatusFailed
+	}
+	o.metrics.OpsDuration.WithLabelValues(op, status).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,215 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package offload
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// fakeClient keeps all objects in memory
+type fakeClient struct {
+	sync.Mutex
+	objects map[string][]byte
+
+	// listErr is returned after all objects were listed
+	listErr error
+	// deleteErr is returned when deleting the object it is keyed by
+	deleteErr map[string]error
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{objects: map[string][]byte{}, deleteErr: map[string]error{}}
+}
+
+func (c *fakeClient) Upload(ctx context.Context, objectName string, r io.Reader) error {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	c.Lock()
+	defer c.Unlock()
+	c.objects[objectName] = content
+	return nil
+}
+
+func (c *fakeClient) Download(ctx context.Context, objectName string) (io.ReadCloser, error) {
+	c.Lock()
+	defer c.Unlock()
+	content, ok := c.objects[objectName]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+func (c *fakeClient) Delete(ctx context.Context, objectName string) error {
+	c.Lock()
+	defer c.Unlock()
+	if err := c.deleteErr[objectName]; err != nil {
+		return err
+	}
+	delete(c.objects, objectName)
+	return nil
+}
+
+func (c *fakeClient) List(ctx context.Context, prefix string, fn func(objectName string)) error {
+	c.Lock()
+	var names []string
+	for name := range c.objects {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	c.Unlock()
+
+	sort.Strings(names)
+	for _, name := range names {
+		fn(name)
+	}
+	return c.listErr
+}
+
+func (c *fakeClient) names() []string {
+	c.Lock()
+	defer c.Unlock()
+	names := make([]string, 0, len(c.objects))
+	for name := range c.objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func newTestOffloader(t *testing.T, client Client) (*Offloader, *monitoring.TenantOffloadMetrics, string) {
+	logger, _ := test.NewNullLogger()
+	dataPath := t.TempDir()
+	metrics := monitoring.NewTenantOffloadMetrics(monitoring.Config{}, prometheus.NewRegistry())
+	cfg := Config{DataPath: dataPath, Concurrency: 2, Timeout: time.Minute}
+	return New(cfg, client, logger, metrics), metrics, dataPath
+}
+
+func TestOffloader(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeClient()
+	o, metrics, dataPath := newTestOffloader(t, client)
+
+	shard := LocalPath(dataPath, "MyClass", "tenant1")
+	files := map[string]string{
+		"indexcount":         "1",
+		"lsm/objects/seg.db": "segment",
+	}
+	for name, content := range files {
+		path := filepath.Join(shard, filepath.FromSlash(name))
+		require.Nil(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+		require.Nil(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	require.Nil(t, o.Upload(ctx, "MyClass", "tenant1", "node1"))
+	assert.Equal(t, []string{"myclass/tenant1/node1/indexcount", "myclass/tenant1/node1/lsm/objects/seg.db"},
+		client.names())
+	assert.Equal(t, float64(len("1")+len("segment")), testutil.ToFloat64(metrics.TransferredBytes))
+
+	require.Nil(t, os.RemoveAll(shard))
+	require.Nil(t, o.Download(ctx, "MyClass", "tenant1", "node1"))
+	for name, content := range files {
+		got, err := os.ReadFile(filepath.Join(shard, filepath.FromSlash(name)))
+		require.Nil(t, err)
+		assert.Equal(t, content, string(got))
+	}
+	assert.Equal(t, float64(len("1")+len("segment")), testutil.ToFloat64(metrics.FetchedBytes))
+
+	require.Nil(t, o.Delete(ctx, "MyClass", "", ""))
+	assert.Empty(t, client.names())
+
+	// one series per operation, all of them succeeded
+	assert.Equal(t, 3, testutil.CollectAndCount(metrics.OpsDuration))
+
+	assert.NotNil(t, o.Upload(ctx, "", "tenant1", "node1"))
+	assert.NotNil(t, o.Delete(ctx, "MyClass", "tenant1", ""))
+}
+
+func TestOffloaderForEachObject(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns error of operation", func(t *testing.T) {
+		client := newFakeClient()
+		client.objects["c/s/n/a"] = nil
+		client.objects["c/s/n/b"] = nil
+		opErr := errors.New("delete failed")
+		client.deleteErr["c/s/n/b"] = opErr
+		o, _, _ := newTestOffloader(t, client)
+
+		err := o.Delete(ctx, "C", "s", "n")
+		assert.ErrorIs(t, err, opErr)
+		assert.ErrorContains(t, err, "delete c/s/n/b")
+	})
+
+	t.Run("returns error of listing", func(t *testing.T) {
+		client := newFakeClient()
+		client.objects["c/s/n/a"] = nil
+		listErr := errors.New("list failed")
+		client.listErr = listErr
+		o, _, _ := newTestOffloader(t, client)
+
+		err := o.Delete(ctx, "C", "s", "n")
+		assert.ErrorIs(t, err, listErr)
+		assert.ErrorContains(t, err, "list objects c/s/n/")
+	})
+
+	t.Run("prefers error of operation over cancelled listing", func(t *testing.T) {
+		opErr := errors.New("download failed")
+		client := &blockingClient{fakeClient: newFakeClient(), opErr: opErr}
+		o, _, _ := newTestOffloader(t, client)
+
+		err := o.Download(ctx, "C", "s", "n")
+		assert.ErrorIs(t, err, opErr)
+	})
+}
+
+// blockingClient lists one object and then blocks until the listing is
+// cancelled, like the provider clients do when an operation fails
+type blockingClient struct {
+	*fakeClient
+	opErr error
+}
+
+func (c *blockingClient) Download(ctx context.Context, objectName string) (io.ReadCloser, error) {
+	return nil, c.opErr
+}
+
+func (c *blockingClient) List(ctx context.Context, prefix string, fn func(objectName string)) error {
+	fn(prefix + "a")
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(5 * time.Second):
+		return errors.New("listing was not cancelled")
+	}
+}
@@ -1103,6 +1103,18 @@ func (p *Provider) OffloadBackend(backend string) (modulecapabilities.OffloadClo
 	return nil, false
 }
 
+// EnabledOffloadBackend returns the name of the enabled offload module, there
+// is at most one
+func (p *Provider) EnabledOffloadBackend() (string, bool) {
+	for _, mod := range p.GetAll() {
+		if _, ok := mod.(modulecapabilities.OffloadCloud); ok &&
+			mod.Type() == modulecapabilities.Offload {
+			return mod.Name(), true
+		}
+	}
+	return "", false
+}
+
 func (p *Provider) EnabledBackupBackends() []modulecapabilities.BackupBackend {
 	var backends []modulecapabilities.BackupBackend
 	for _, mod := range p.GetAll() {
@@ -161,7 +161,7 @@ func NewTenantOffloadMetrics(cfg Config, reg prometheus.Registerer) *TenantOfflo
 			Namespace: cfg.MetricsNamespace,
 			Name:      "tenant_offload_operation_duration_seconds",
 			Buckets:   LatencyBuckets,
-		}, []string{"operation", "status"}), // status can be "success" or "failure"
+		}, []string{"operation", "status"}), // status can be "success" or "failed"
 	}
 }
 
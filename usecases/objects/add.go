@@ -45,6 +45,10 @@ func (m *Manager) AddObject(ctx context.Context, principal *models.Principal, ob
 		return nil, err
 	}
 
+	if err := m.schemaManager.CheckDataWrite(className); err != nil {
+		return nil, NewErrReadOnly(err)
+	}
+
 	m.metrics.AddObjectInc()
 	defer m.metrics.AddObjectDec()
 
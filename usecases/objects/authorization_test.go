@@ -162,7 +162,7 @@ func Test_Kinds_Authorization(t *testing.T) {
 			testedMethods[i] = test.methodName
 		}
 
-		for _, method := range allExportedMethods(&Manager{}, "") {
+		for _, method := range allExportedMethods(&Manager{}, "SetMasker", "SetQueryCounter") {
 			assert.Contains(t, testedMethods, method)
 		}
 	})
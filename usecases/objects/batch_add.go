@@ -138,6 +138,13 @@ func (b *BatchManager) validateAndGetVector(ctx context.Context, principal *mode
 			continue
 		}
 
+		if err := b.schemaManager.CheckDataWrite(obj.Class); err != nil {
+			batchObjects[i].Object = obj
+			batchObjects[i].UUID = obj.ID
+			batchObjects[i].Err = NewErrReadOnly(err)
+			continue
+		}
+
 		schemaVersion, err := b.autoSchemaManager.autoSchema(ctx, principal, true, fetchedClasses, obj)
 		if err != nil {
 			batchObjects[i].Err = err
@@ -59,6 +59,12 @@ func (b *BatchManager) DeleteObjectsFromGRPCAfterAuth(ctx context.Context, princ
 	b.metrics.BatchDeleteInc()
 	defer b.metrics.BatchDeleteDec()
 
+	if !params.DryRun {
+		if err := b.schemaManager.CheckDataWrite(params.ClassName.String()); err != nil {
+			return BatchDeleteResult{}, NewErrReadOnly(err)
+		}
+	}
+
 	deletionTime := time.UnixMilli(b.timeSource.Now())
 	return b.vectorRepo.BatchDeleteObjects(ctx, params, deletionTime, repl, tenant, 0)
 }
@@ -72,6 +78,13 @@ func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Prin
 		return nil, errors.Wrap(err, "validate")
 	}
 
+	// a dry run doesn't delete anything, so it's allowed in read-only mode
+	if !params.DryRun {
+		if err := b.schemaManager.CheckDataWrite(params.ClassName.String()); err != nil {
+			return nil, NewErrReadOnly(err)
+		}
+	}
+
 	// Ensure that the local schema has caught up to the version we used to validate
 	if err := b.schemaManager.WaitForUpdate(ctx, schemaVersion); err != nil {
 		return nil, fmt.Errorf("error waiting for local schema to catch up to version %d: %w", schemaVersion, err)
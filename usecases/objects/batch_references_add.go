@@ -102,6 +102,11 @@ func (b *BatchManager) addReferences(ctx context.Context, principal *models.Prin
 			continue
 		}
 
+		if err := b.schemaManager.CheckDataWrite(ref.From.Class.String()); err != nil {
+			refs[i].Err = NewErrReadOnly(err)
+			continue
+		}
+
 		if shouldValidateMultiTenantRef(ref.Tenant, ref.From, ref.To) {
 			// can only validate multi-tenancy when everything above succeeds
 			classVersion, err := validateReferenceMultiTenancy(ctx, principal, b.schemaManager, b.vectorRepo, ref.From, ref.To, ref.Tenant, fetchedClasses)
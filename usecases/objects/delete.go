@@ -23,6 +23,7 @@ import (
 
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	authzerrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/memwatch"
@@ -40,6 +41,12 @@ func (m *Manager) DeleteObject(ctx context.Context,
 	if err != nil {
 		return err
 	}
+
+	// the deprecated endpoint without class name checks the write mode of
+	// each object's collection in deleteObjectFromRepo
+	if err := m.schemaManager.CheckDataWrite(className); err != nil {
+		return NewErrReadOnly(err)
+	}
 	ctx = classcache.ContextWithClassCache(ctx)
 
 	if err := m.allocChecker.CheckAlloc(memwatch.EstimateObjectDeleteMemory()); err != nil {
@@ -93,7 +100,7 @@ func (m *Manager) deleteObjectFromRepo(ctx context.Context, id strfmt.UUID, dele
 	// https://github.com/weaviate/weaviate/issues/1836
 	deleteCounter := 0
 	for {
-		objectRes, err := m.getObjectFromRepo(ctx, "", id, additional.Properties{}, nil, "")
+		objectRes, err := m.getObjectFromRepo(ctx, "", id, search.SelectProperties{}, additional.Properties{}, nil, "")
 		if err != nil {
 			if errors.As(err, &ErrNotFound{}) {
 				if deleteCounter == 0 {
@@ -105,6 +112,9 @@ func (m *Manager) deleteObjectFromRepo(ctx context.Context, id strfmt.UUID, dele
 		}
 
 		object := objectRes.Object()
+		if err := m.schemaManager.CheckDataWrite(object.Class); err != nil {
+			return NewErrReadOnly(err)
+		}
 		err = m.vectorRepo.DeleteObject(ctx, object.Class, id, deletionTime, nil, "", 0)
 		if err != nil {
 			return NewErrInternal("could not delete object from vector repo: %v", err)
@@ -115,6 +115,25 @@ func NewErrMultiTenancy(err error) ErrMultiTenancy {
 	return ErrMultiTenancy{err}
 }
 
+// ErrReadOnly indicates that a write was rejected because of the write mode
+// of the cluster or the collection
+type ErrReadOnly struct {
+	err error
+}
+
+func (e ErrReadOnly) Error() string {
+	return e.err.Error()
+}
+
+func (e ErrReadOnly) Unwrap() error {
+	return e.err
+}
+
+// NewErrReadOnly with error signature
+func NewErrReadOnly(err error) ErrReadOnly {
+	return ErrReadOnly{err}
+}
+
 // This error is thrown by the replication logic when an object has either:
 //
 // 1. been deleted locally but exists remotely
@@ -50,6 +50,16 @@ type fakeSchemaManager struct {
 	GetSchemaResponse schema.Schema
 	GetschemaErr      error
 	tenantsEnabled    bool
+	dataWriteErr      error
+	// readOnlyClasses reject data writes in addition to dataWriteErr
+	readOnlyClasses map[string]error
+}
+
+func (f *fakeSchemaManager) CheckDataWrite(class string) error {
+	if err := f.readOnlyClasses[class]; err != nil {
+		return err
+	}
+	return f.dataWriteErr
 }
 
 func (f *fakeSchemaManager) UpdatePropertyAddDataType(ctx context.Context, principal *models.Principal,
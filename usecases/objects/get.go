@@ -28,9 +28,10 @@ import (
 	"github.com/weaviate/weaviate/usecases/auth/authorization/filter"
 )
 
-// GetObject Class from the connected DB
+// GetObject Class from the connected DB. If projection is set, only the
+// selected properties are returned.
 func (m *Manager) GetObject(ctx context.Context, principal *models.Principal,
-	class string, id strfmt.UUID, additional additional.Properties,
+	class string, id strfmt.UUID, additional additional.Properties, projection *Projection,
 	replProps *additional.ReplicationProperties, tenant string,
 ) (*models.Object, error) {
 	err := m.authorizer.Authorize(principal, authorization.READ, authorization.Objects(class, tenant, id))
@@ -41,7 +42,20 @@ func (m *Manager) GetObject(ctx context.Context, principal *models.Principal,
 	m.metrics.GetObjectInc()
 	defer m.metrics.GetObjectDec()
 
-	res, err := m.getObjectFromRepo(ctx, class, id, additional, replProps, tenant)
+	// without a class the properties can't be selected, but the vectors are
+	// still skipped
+	var props search.SelectProperties
+	if projection != nil {
+		additional.Projected = true
+		if class != "" {
+			props, err = projection.selectProperties(m.schemaManager.ReadOnlyClass(class))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	res, err := m.getObjectFromRepo(ctx, class, id, props, additional, replProps, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -50,13 +64,18 @@ func (m *Manager) GetObject(ctx context.Context, principal *models.Principal,
 		m.trackUsageSingle(res)
 	}
 
-	return res.ObjectWithVector(additional.Vector), nil
+	obj := res.ObjectWithVector(additional.Vector)
+	projection.apply(obj)
+	m.masker.ForPrincipal(principal).MaskObject(obj)
+	return obj, nil
 }
 
-// GetObjects Class from the connected DB
+// GetObjects Class from the connected DB. The objects may be of different
+// classes, so a projection doesn't select the properties read from storage
+// and is applied to the results. Only the vectors are skipped.
 func (m *Manager) GetObjects(ctx context.Context, principal *models.Principal,
 	offset *int64, limit *int64, sort *string, order *string, after *string,
-	addl additional.Properties, tenant string,
+	addl additional.Properties, projection *Projection, tenant string,
 ) ([]*models.Object, error) {
 	err := m.authorizer.Authorize(principal, authorization.READ, authorization.Objects("", tenant, ""))
 	if err != nil {
@@ -66,6 +85,7 @@ func (m *Manager) GetObjects(ctx context.Context, principal *models.Principal,
 	m.metrics.GetObjectInc()
 	defer m.metrics.GetObjectDec()
 
+	addl.Projected = projection != nil
 	objects, err := m.getObjectsFromRepo(ctx, offset, limit, sort, order, after, addl, tenant)
 	if err != nil {
 		return nil, err
@@ -83,6 +103,16 @@ func (m *Manager) GetObjects(ctx context.Context, principal *models.Principal,
 		},
 	)
 
+	// listing is not restricted to a collection, so it counts as a query of
+	// every collection it returned objects of
+	classes := make([]string, len(filteredObjects))
+	for i, obj := range filteredObjects {
+		classes[i] = obj.Class
+	}
+	m.queries.GetPerCollection(classes, tenant)
+
+	projection.apply(filteredObjects...)
+	m.masker.ForPrincipal(principal).MaskObjects(filteredObjects)
 	return filteredObjects, nil
 }
 
@@ -97,7 +127,7 @@ func (m *Manager) GetObjectsClass(ctx context.Context, principal *models.Princip
 	m.metrics.GetObjectInc()
 	defer m.metrics.GetObjectDec()
 
-	res, err := m.getObjectFromRepo(ctx, "", id, additional.Properties{}, nil, "")
+	res, err := m.getObjectFromRepo(ctx, "", id, search.SelectProperties{}, additional.Properties{}, nil, "")
 	if err != nil {
 		return nil, err
 	}
@@ -114,12 +144,13 @@ func (m *Manager) GetObjectClassFromName(ctx context.Context, principal *models.
 }
 
 func (m *Manager) getObjectFromRepo(ctx context.Context, class string, id strfmt.UUID,
-	adds additional.Properties, repl *additional.ReplicationProperties, tenant string,
+	props search.SelectProperties, adds additional.Properties,
+	repl *additional.ReplicationProperties, tenant string,
 ) (res *search.Result, err error) {
 	if class != "" {
-		res, err = m.vectorRepo.Object(ctx, class, id, search.SelectProperties{}, adds, repl, tenant)
+		res, err = m.vectorRepo.Object(ctx, class, id, props, adds, repl, tenant)
 	} else {
-		res, err = m.vectorRepo.ObjectByID(ctx, id, search.SelectProperties{}, adds, tenant)
+		res, err = m.vectorRepo.ObjectByID(ctx, id, props, adds, tenant)
 	}
 	if err != nil {
 		switch {
@@ -75,7 +75,7 @@ func Test_GetAction(t *testing.T) {
 		vectorRepo.On("ObjectByID", id, mock.Anything, mock.Anything).Return((*search.Result)(nil), nil).Once()
 
 		_, err := manager.GetObject(context.Background(), &models.Principal{}, "",
-			id, additional.Properties{}, nil, "")
+			id, additional.Properties{}, nil, nil, "")
 		assert.Equal(t, NewErrNotFound("no object with id '99ee9968-22ec-416a-9032-cff80f2f7fdf'"), err)
 	})
 
@@ -98,7 +98,7 @@ func Test_GetAction(t *testing.T) {
 		}
 
 		res, err := manager.GetObject(context.Background(), &models.Principal{}, "",
-			id, additional.Properties{}, nil, "")
+			id, additional.Properties{}, nil, nil, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
@@ -127,7 +127,7 @@ func Test_GetAction(t *testing.T) {
 		metrics.On("AddUsageDimensions", "ActionClass", "get_rest", "single_include_vector", 3)
 
 		res, err := manager.GetObject(context.Background(), &models.Principal{}, "",
-			id, additional.Properties{Vector: true}, nil, "")
+			id, additional.Properties{Vector: true}, nil, nil, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
@@ -157,7 +157,7 @@ func Test_GetAction(t *testing.T) {
 		metrics.On("AddUsageDimensions", "ActionClass", "get_rest", "single_include_vector", 3)
 
 		res, err := manager.GetObject(context.Background(), &models.Principal{},
-			"ActionClass", id, additional.Properties{Vector: true}, nil, "")
+			"ActionClass", id, additional.Properties{Vector: true}, nil, nil, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
@@ -185,7 +185,7 @@ func Test_GetAction(t *testing.T) {
 			},
 		}
 
-		res, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, nil, nil, nil, nil, additional.Properties{}, "")
+		res, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, nil, nil, nil, nil, additional.Properties{}, nil, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
@@ -218,7 +218,7 @@ func Test_GetAction(t *testing.T) {
 			},
 		}
 
-		res, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, nil, nil, nil, nil, additional.Properties{Vector: true}, "")
+		res, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, nil, nil, nil, nil, additional.Properties{Vector: true}, nil, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
@@ -246,7 +246,7 @@ func Test_GetAction(t *testing.T) {
 			},
 		}
 
-		res, err := manager.GetObjects(context.Background(), &models.Principal{}, ptInt64(7), ptInt64(2), nil, nil, nil, additional.Properties{}, "")
+		res, err := manager.GetObjects(context.Background(), &models.Principal{}, ptInt64(7), ptInt64(2), nil, nil, nil, additional.Properties{}, nil, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
@@ -254,7 +254,7 @@ func Test_GetAction(t *testing.T) {
 	t.Run("with an offset greater than the maximum", func(t *testing.T) {
 		reset()
 
-		_, err := manager.GetObjects(context.Background(), &models.Principal{}, ptInt64(201), ptInt64(2), nil, nil, nil, additional.Properties{}, "")
+		_, err := manager.GetObjects(context.Background(), &models.Principal{}, ptInt64(201), ptInt64(2), nil, nil, nil, additional.Properties{}, nil, "")
 		require.NotNil(t, err)
 		assert.Contains(t, err.Error(), "query maximum results exceeded")
 	})
@@ -262,7 +262,7 @@ func Test_GetAction(t *testing.T) {
 	t.Run("with a limit greater than the minimum", func(t *testing.T) {
 		reset()
 
-		_, err := manager.GetObjects(context.Background(), &models.Principal{}, ptInt64(0), ptInt64(202), nil, nil, nil, additional.Properties{}, "")
+		_, err := manager.GetObjects(context.Background(), &models.Principal{}, ptInt64(0), ptInt64(202), nil, nil, nil, additional.Properties{}, nil, "")
 		require.NotNil(t, err)
 		assert.Contains(t, err.Error(), "query maximum results exceeded")
 	})
@@ -270,7 +270,7 @@ func Test_GetAction(t *testing.T) {
 	t.Run("with limit and offset individually smaller, but combined greater", func(t *testing.T) {
 		reset()
 
-		_, err := manager.GetObjects(context.Background(), &models.Principal{}, ptInt64(150), ptInt64(150), nil, nil, nil, additional.Properties{}, "")
+		_, err := manager.GetObjects(context.Background(), &models.Principal{}, ptInt64(150), ptInt64(150), nil, nil, nil, additional.Properties{}, nil, "")
 		require.NotNil(t, err)
 		assert.Contains(t, err.Error(), "query maximum results exceeded")
 	})
@@ -292,7 +292,7 @@ func Test_GetAction(t *testing.T) {
 						ModuleParams: map[string]interface{}{
 							"featureProjection": getDefaultParam("featureProjection"),
 						},
-					}, nil, "")
+					}, nil, nil, "")
 				assert.Equal(t, errors.New("get extend: unknown capability: featureProjection").Error(), err.Error())
 			})
 
@@ -311,7 +311,7 @@ func Test_GetAction(t *testing.T) {
 						ModuleParams: map[string]interface{}{
 							"semanticPath": getDefaultParam("semanticPath"),
 						},
-					}, nil, "")
+					}, nil, nil, "")
 				assert.Equal(t, errors.New("get extend: unknown capability: semanticPath").Error(), err.Error())
 			})
 
@@ -365,7 +365,7 @@ func Test_GetAction(t *testing.T) {
 						ModuleParams: map[string]interface{}{
 							"nearestNeighbors": true,
 						},
-					}, nil, "")
+					}, nil, nil, "")
 				require.Nil(t, err)
 				assert.Equal(t, expected, res)
 			})
@@ -426,7 +426,7 @@ func Test_GetAction(t *testing.T) {
 					ModuleParams: map[string]interface{}{
 						"nearestNeighbors": true,
 					},
-				}, "")
+				}, nil, "")
 				require.Nil(t, err)
 				assert.Equal(t, expected, res)
 			})
@@ -475,7 +475,7 @@ func Test_GetAction(t *testing.T) {
 					ModuleParams: map[string]interface{}{
 						"featureProjection": getDefaultParam("featureProjection"),
 					},
-				}, "")
+				}, nil, "")
 				require.Nil(t, err)
 				assert.Equal(t, expected, res)
 			})
@@ -528,7 +528,7 @@ func Test_GetAction(t *testing.T) {
 				},
 			}
 
-			res, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, ptInt64(10), &sort, &asc, nil, additional.Properties{}, "")
+			res, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, ptInt64(10), &sort, &asc, nil, additional.Properties{}, nil, "")
 			require.Nil(t, err)
 			assert.Equal(t, expected, res)
 		})
@@ -580,7 +580,7 @@ func Test_GetAction(t *testing.T) {
 				},
 			}
 
-			res, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, ptInt64(10), &sort, &asc, nil, additional.Properties{}, "")
+			res, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, ptInt64(10), &sort, &asc, nil, additional.Properties{}, nil, "")
 			require.Nil(t, err)
 			assert.Equal(t, expected, res)
 		})
@@ -606,7 +606,7 @@ func Test_GetAction(t *testing.T) {
 			vectorRepo.On("ObjectSearch", mock.Anything, mock.Anything, expectedSort, mock.Anything, mock.Anything,
 				mock.Anything).Return(result, nil).Once()
 
-			_, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, ptInt64(10), &sort, nil, nil, additional.Properties{}, "")
+			_, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, ptInt64(10), &sort, nil, nil, additional.Properties{}, nil, "")
 			require.Nil(t, err)
 		})
 
@@ -632,7 +632,7 @@ func Test_GetAction(t *testing.T) {
 			vectorRepo.On("ObjectSearch", mock.Anything, mock.Anything, expectedSort, mock.Anything, mock.Anything,
 				mock.Anything).Return(result, nil).Once()
 
-			_, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, ptInt64(10), &sort, nil, nil, additional.Properties{}, "")
+			_, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, ptInt64(10), &sort, nil, nil, additional.Properties{}, nil, "")
 			require.Nil(t, err)
 		})
 
@@ -654,7 +654,7 @@ func Test_GetAction(t *testing.T) {
 			vectorRepo.On("ObjectSearch", mock.Anything, mock.Anything, expectedSort, mock.Anything, mock.Anything,
 				mock.Anything).Return(result, nil).Once()
 
-			_, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, ptInt64(10), nil, &order, nil, additional.Properties{}, "")
+			_, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, ptInt64(10), nil, &order, nil, additional.Properties{}, nil, "")
 			require.Nil(t, err)
 		})
 	})
@@ -703,7 +703,7 @@ func Test_GetThing(t *testing.T) {
 		vectorRepo.On("ObjectByID", id, mock.Anything, mock.Anything).Return((*search.Result)(nil), nil).Once()
 
 		_, err := manager.GetObject(context.Background(), &models.Principal{}, "", id,
-			additional.Properties{}, nil, "")
+			additional.Properties{}, nil, nil, "")
 		assert.Equal(t, NewErrNotFound("no object with id '99ee9968-22ec-416a-9032-cff80f2f7fdf'"), err)
 	})
 
@@ -726,7 +726,7 @@ func Test_GetThing(t *testing.T) {
 		}
 
 		res, err := manager.GetObject(context.Background(), &models.Principal{}, "", id,
-			additional.Properties{}, nil, "")
+			additional.Properties{}, nil, nil, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
@@ -754,7 +754,7 @@ func Test_GetThing(t *testing.T) {
 			},
 		}
 
-		res, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, nil, nil, nil, nil, additional.Properties{}, "")
+		res, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, nil, nil, nil, nil, additional.Properties{}, nil, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
@@ -776,7 +776,7 @@ func Test_GetThing(t *testing.T) {
 						ModuleParams: map[string]interface{}{
 							"featureProjection": getDefaultParam("featureProjection"),
 						},
-					}, nil, "")
+					}, nil, nil, "")
 				assert.Equal(t, errors.New("get extend: unknown capability: featureProjection").Error(), err.Error())
 			})
 
@@ -830,7 +830,7 @@ func Test_GetThing(t *testing.T) {
 						ModuleParams: map[string]interface{}{
 							"nearestNeighbors": true,
 						},
-					}, nil, "")
+					}, nil, nil, "")
 				require.Nil(t, err)
 				assert.Equal(t, expected, res)
 			})
@@ -891,7 +891,7 @@ func Test_GetThing(t *testing.T) {
 					ModuleParams: map[string]interface{}{
 						"nearestNeighbors": true,
 					},
-				}, "")
+				}, nil, "")
 				require.Nil(t, err)
 				assert.Equal(t, expected, res)
 			})
@@ -940,7 +940,7 @@ func Test_GetThing(t *testing.T) {
 					ModuleParams: map[string]interface{}{
 						"featureProjection": getDefaultParam("featureProjection"),
 					},
-				}, "")
+				}, nil, "")
 				require.Nil(t, err)
 				assert.Equal(t, expected, res)
 			})
@@ -973,7 +973,7 @@ func Test_GetObject(t *testing.T) {
 	t.Run("without projection", func(t *testing.T) {
 		m := newFakeGetManager(schema)
 		m.repo.On("Object", className, id, mock.Anything, mock.Anything, "").Return((*search.Result)(nil), nil).Once()
-		_, err := m.GetObject(context.Background(), &principal, className, id, adds, nil, "")
+		_, err := m.GetObject(context.Background(), &principal, className, id, adds, nil, nil, "")
 		if err == nil {
 			t.Errorf("GetObject() must return an error for non existing object")
 		}
@@ -986,7 +986,7 @@ func Test_GetObject(t *testing.T) {
 			VectorWeights: (map[string]string)(nil),
 		}
 
-		got, err := m.GetObject(context.Background(), &principal, className, id, adds, nil, "")
+		got, err := m.GetObject(context.Background(), &principal, className, id, adds, nil, nil, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, got)
 	})
@@ -1016,7 +1016,7 @@ func Test_GetObject(t *testing.T) {
 				ModuleParams: map[string]interface{}{
 					"Unknown": getDefaultParam("Unknown"),
 				},
-			}, nil, "")
+			}, nil, nil, "")
 		if err == nil {
 			t.Errorf("GetObject() must return unknown feature projection error")
 		}
@@ -1044,7 +1044,7 @@ func Test_GetObject(t *testing.T) {
 				ModuleParams: map[string]interface{}{
 					"nearestNeighbors": true,
 				},
-			}, nil, "")
+			}, nil, nil, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
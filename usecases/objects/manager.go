@@ -33,7 +33,9 @@ import (
 	"github.com/weaviate/weaviate/entities/versioned"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/masking"
 	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/usage"
 )
 
 type schemaManager interface {
@@ -66,6 +68,10 @@ type schemaManager interface {
 
 	// GetConsistentSchema retrieves a locally cached copy of the schema
 	GetConsistentSchema(principal *models.Principal, consistency bool) (schema.Schema, error)
+
+	// CheckDataWrite returns an error if the write mode of the cluster or the
+	// class rejects writes
+	CheckDataWrite(class string) error
 }
 
 // Manager manages kind changes at a use-case level, i.e. agnostic of
@@ -81,6 +87,8 @@ type Manager struct {
 	autoSchemaManager *autoSchemaManager
 	metrics           objectsMetrics
 	allocChecker      *memwatch.Monitor
+	masker            *masking.Masker
+	queries           *usage.QueryCounter
 }
 
 type objectsMetrics interface {
@@ -176,6 +184,17 @@ func NewManager(schemaManager schemaManager,
 	}
 }
 
+// SetMasker sets the masking policies that are applied to objects before
+// they are returned to the caller
+func (m *Manager) SetMasker(masker *masking.Masker) {
+	m.masker = masker
+}
+
+// SetQueryCounter sets the counter used for usage reporting
+func (m *Manager) SetQueryCounter(queries *usage.QueryCounter) {
+	m.queries = queries
+}
+
 func generateUUID() (strfmt.UUID, error) {
 	id, err := uuid.NewRandom()
 	if err != nil {
@@ -58,6 +58,10 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	className := schema.UppercaseClassName(updates.Class)
 	updates.Class = className
 
+	if err := m.schemaManager.CheckDataWrite(className); err != nil {
+		return &Error{err.Error(), StatusUnprocessableEntity, NewErrReadOnly(err)}
+	}
+
 	ctx = classcache.ContextWithClassCache(ctx)
 
 	// we don't reveal any info that the end users cannot get through the structure of the data anyway
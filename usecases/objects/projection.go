@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"slices"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// Projection restricts the properties of the objects returned to the caller.
+// At most one of Include and Exclude is set. A nil Projection returns all
+// properties.
+type Projection struct {
+	Include []string
+	Exclude []string
+}
+
+// NewProjection returns the Projection for the given lists of properties to
+// include and exclude. It returns nil if both lists are empty.
+func NewProjection(include, exclude []string) (*Projection, error) {
+	if len(include) == 0 && len(exclude) == 0 {
+		return nil, nil
+	}
+	if len(include) > 0 && len(exclude) > 0 {
+		return nil, NewErrInvalidUserInput("properties and exclude_properties cannot be combined")
+	}
+	return &Projection{Include: include, Exclude: exclude}, nil
+}
+
+// selectProperties returns the properties of class that need to be read from
+// storage. Only these properties are unmarshalled and the vectors are skipped,
+// unless they are requested explicitly. The result is nil if all properties
+// need to be read, which is always the case if the class is unknown.
+func (p *Projection) selectProperties(class *models.Class) (search.SelectProperties, error) {
+	if p == nil || class == nil {
+		return nil, nil
+	}
+
+	classProps := make([]string, len(class.Properties))
+	for i, prop := range class.Properties {
+		classProps[i] = prop.Name
+	}
+	for _, name := range append(p.Include, p.Exclude...) {
+		if !slices.Contains(classProps, name) {
+			return nil, NewErrInvalidUserInput("no such property %q in class %q", name, class.Class)
+		}
+	}
+
+	var props search.SelectProperties
+	for _, name := range classProps {
+		if p.selects(name) {
+			props = append(props, search.SelectProperty{Name: name, IsPrimitive: true})
+		}
+	}
+	return props, nil
+}
+
+func (p *Projection) selects(name string) bool {
+	if len(p.Include) > 0 {
+		return slices.Contains(p.Include, name)
+	}
+	return !slices.Contains(p.Exclude, name)
+}
+
+// apply removes all properties that aren't selected from the objects
+func (p *Projection) apply(objs ...*models.Object) {
+	if p == nil {
+		return
+	}
+
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		props, ok := obj.Properties.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for name := range props {
+			if !p.selects(name) {
+				delete(props, name)
+			}
+		}
+	}
+}
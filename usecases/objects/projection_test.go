@@ -0,0 +1,172 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestNewProjection(t *testing.T) {
+	p, err := NewProjection(nil, nil)
+	require.Nil(t, err)
+	assert.Nil(t, p)
+
+	p, err = NewProjection([]string{"title"}, nil)
+	require.Nil(t, err)
+	assert.Equal(t, &Projection{Include: []string{"title"}}, p)
+
+	_, err = NewProjection([]string{"title"}, []string{"body"})
+	assert.ErrorAs(t, err, &ErrInvalidUserInput{})
+}
+
+func TestProjectionSelectProperties(t *testing.T) {
+	class := &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title"}, {Name: "body"}, {Name: "author"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		projection *Projection
+		class      *models.Class
+		expected   []string
+		err        string
+	}{
+		{
+			name:       "no projection",
+			projection: nil,
+			class:      class,
+		},
+		{
+			name:       "unknown class",
+			projection: &Projection{Include: []string{"title"}},
+		},
+		{
+			name:       "include",
+			projection: &Projection{Include: []string{"author", "title"}},
+			class:      class,
+			expected:   []string{"title", "author"},
+		},
+		{
+			name:       "exclude",
+			projection: &Projection{Exclude: []string{"body"}},
+			class:      class,
+			expected:   []string{"title", "author"},
+		},
+		{
+			name:       "unknown property",
+			projection: &Projection{Include: []string{"summary"}},
+			class:      class,
+			err:        `no such property "summary" in class "Article"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			props, err := tt.projection.selectProperties(tt.class)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.Nil(t, err)
+			if tt.expected == nil {
+				assert.Nil(t, props)
+				return
+			}
+			assert.Equal(t, tt.expected, props.GetPropertyNames())
+		})
+	}
+}
+
+func TestProjectionApply(t *testing.T) {
+	newObj := func() *models.Object {
+		return &models.Object{Properties: map[string]interface{}{
+			"title": "a", "body": "b", "author": "c",
+		}}
+	}
+
+	obj := newObj()
+	(&Projection{Include: []string{"title"}}).apply(obj)
+	assert.Equal(t, map[string]interface{}{"title": "a"}, obj.Properties)
+
+	obj = newObj()
+	(&Projection{Exclude: []string{"body"}}).apply(obj)
+	assert.Equal(t, map[string]interface{}{"title": "a", "author": "c"}, obj.Properties)
+
+	obj = newObj()
+	(*Projection)(nil).apply(obj)
+	assert.Equal(t, newObj(), obj)
+}
+
+func TestGetObjectWithProjection(t *testing.T) {
+	id := strfmt.UUID("99ee9968-22ec-416a-9032-cff80f2f7fdf")
+	vectorRepo := &fakeVectorRepo{}
+	schemaManager := &fakeSchemaManager{
+		GetSchemaResponse: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{{
+			Class:      "Article",
+			Properties: []*models.Property{{Name: "title"}, {Name: "body"}},
+		}}}},
+	}
+	logger, _ := test.NewNullLogger()
+	manager := NewManager(schemaManager, &config.WeaviateConfig{}, logger,
+		mocks.NewMockAuthorizer(), vectorRepo, getFakeModulesProvider(), &fakeMetrics{}, nil)
+
+	// only the selected property is read from storage, without vectors
+	selected := search.SelectProperties{{Name: "title", IsPrimitive: true}}
+	vectorRepo.On("Object", "Article", id, selected, additional.Properties{Projected: true}, "").
+		Return(&search.Result{
+			ID:        id,
+			ClassName: "Article",
+			Schema:    map[string]interface{}{"title": "a"},
+		}, nil).Once()
+
+	projection, err := NewProjection([]string{"title"}, nil)
+	require.Nil(t, err)
+	res, err := manager.GetObject(context.Background(), &models.Principal{},
+		"Article", id, additional.Properties{}, projection, nil, "")
+	require.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"title": "a"}, res.Properties)
+	assert.Nil(t, res.Vector)
+
+	// without a class all properties are read and the vectors are skipped
+	vectorRepo.On("ObjectByID", id, search.SelectProperties(nil), additional.Properties{Projected: true}).
+		Return(&search.Result{
+			ID:        id,
+			ClassName: "Article",
+			Schema:    map[string]interface{}{"title": "a", "body": "b"},
+		}, nil).Once()
+	res, err = manager.GetObject(context.Background(), &models.Principal{},
+		"", id, additional.Properties{}, projection, nil, "")
+	require.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"title": "a"}, res.Properties)
+
+	projection, err = NewProjection([]string{"summary"}, nil)
+	require.Nil(t, err)
+	_, err = manager.GetObject(context.Background(), &models.Principal{},
+		"Article", id, additional.Properties{}, projection, nil, "")
+	assert.ErrorAs(t, err, &ErrInvalidUserInput{})
+}
@@ -31,6 +31,8 @@ type QueryInput struct {
 	Sort       []filters.Sort
 	Tenant     string
 	Additional additional.Properties
+	// Properties are the only properties read from storage if set
+	Properties []string
 }
 
 type QueryParams struct {
@@ -42,6 +44,7 @@ type QueryParams struct {
 	Order      *string
 	Tenant     *string
 	Additional additional.Properties
+	Projection *Projection
 }
 
 func (q *QueryParams) inputs(m *Manager) (*QueryInput, error) {
@@ -86,6 +89,15 @@ func (m *Manager) Query(ctx context.Context, principal *models.Principal, params
 		return nil, &Error{"offset or limit", StatusBadRequest, err}
 	}
 
+	props, err := params.Projection.selectProperties(m.schemaManager.ReadOnlyClass(q.Class))
+	if err != nil {
+		return nil, &Error{"properties", StatusBadRequest, err}
+	}
+	if len(props) > 0 {
+		q.Properties = props.GetPropertyNames()
+	}
+	q.Additional.Projected = params.Projection != nil
+
 	filteredQuery := filter.New[*QueryInput](m.authorizer, m.config.Config.Authorization.Rbac).Filter(
 		m.logger,
 		principal,
@@ -104,6 +116,7 @@ func (m *Manager) Query(ctx context.Context, principal *models.Principal, params
 	if rerr != nil {
 		return nil, rerr
 	}
+	m.queries.Get(q.Class, q.Tenant)
 
 	if m.modulesProvider != nil {
 		res, err = m.modulesProvider.ListObjectsAdditionalExtend(ctx, res, q.Additional.ModuleParams)
@@ -116,5 +129,8 @@ func (m *Manager) Query(ctx context.Context, principal *models.Principal, params
 		m.trackUsageList(res)
 	}
 
-	return res.ObjectsWithVector(q.Additional.Vector), nil
+	objs := res.ObjectsWithVector(q.Additional.Vector)
+	params.Projection.apply(objs...)
+	m.masker.ForPrincipal(principal).MaskObjects(objs)
+	return objs, nil
 }
@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/verbosity"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_ReadOnly_RejectsWrites(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		id         = strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+		readOnly   = errors.New("writes are rejected: cluster write mode is READ_ONLY")
+		vectorRepo *fakeVectorRepo
+		manager    *Manager
+		batch      *BatchManager
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{{
+					Class:      "Foo",
+					Vectorizer: config.VectorizerModuleNone,
+					Properties: []*models.Property{{Name: "name", DataType: schema.DataTypeText.PropString()}},
+				}},
+			}},
+			dataWriteErr: readOnly,
+		}
+		cfg := &config.WeaviateConfig{}
+		logger, _ := test.NewNullLogger()
+		authorizer := mocks.NewMockAuthorizer()
+		modulesProvider := getFakeModulesProvider()
+		manager = NewManager(schemaManager, cfg, logger, authorizer,
+			vectorRepo, modulesProvider, &fakeMetrics{}, nil)
+		batch = NewBatchManager(vectorRepo, modulesProvider, schemaManager, cfg, logger, authorizer, nil)
+	}
+
+	t.Run("add object", func(t *testing.T) {
+		reset()
+		_, err := manager.AddObject(ctx, nil, &models.Object{Class: "Foo", ID: id}, nil)
+		require.NotNil(t, err)
+		assert.ErrorAs(t, err, &ErrReadOnly{})
+		assert.ErrorIs(t, err, readOnly)
+		vectorRepo.AssertNotCalled(t, "PutObject", mock.Anything)
+	})
+
+	t.Run("update object", func(t *testing.T) {
+		reset()
+		_, err := manager.UpdateObject(ctx, nil, "Foo", id, &models.Object{Class: "Foo", ID: id}, nil)
+		assert.ErrorAs(t, err, &ErrReadOnly{})
+	})
+
+	t.Run("merge object", func(t *testing.T) {
+		reset()
+		err := manager.MergeObject(ctx, nil, &models.Object{Class: "Foo", ID: id}, nil)
+		require.NotNil(t, err)
+		assert.True(t, err.UnprocessableEntity())
+		assert.ErrorAs(t, err, &ErrReadOnly{})
+	})
+
+	t.Run("delete object", func(t *testing.T) {
+		reset()
+		err := manager.DeleteObject(ctx, nil, "Foo", id, nil, "")
+		assert.ErrorAs(t, err, &ErrReadOnly{})
+		vectorRepo.AssertNotCalled(t, "DeleteObject", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("add reference", func(t *testing.T) {
+		reset()
+		err := manager.AddObjectReference(ctx, nil, &AddReferenceInput{
+			Class: "Foo", ID: id, Property: "ref",
+			Ref: models.SingleRef{Beacon: strfmt.URI("weaviate://localhost/Foo/" + id)},
+		}, nil, "")
+		require.NotNil(t, err)
+		assert.True(t, err.UnprocessableEntity())
+		assert.ErrorAs(t, err, &ErrReadOnly{})
+	})
+
+	t.Run("batch add objects", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+		res, err := batch.AddObjects(ctx, nil, []*models.Object{
+			{Class: "Foo", ID: id},
+			{Class: "Foo"},
+		}, nil, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		for _, obj := range res {
+			assert.ErrorAs(t, obj.Err, &ErrReadOnly{})
+			assert.NotNil(t, obj.Object)
+		}
+	})
+
+	t.Run("batch delete objects", func(t *testing.T) {
+		reset()
+		_, err := batch.DeleteObjects(ctx, nil, &models.BatchDeleteMatch{
+			Class: "Foo",
+			Where: &models.WhereFilter{
+				Operator:  "Equal",
+				Path:      []string{"name"},
+				ValueText: ptString("foo"),
+			},
+		}, nil, ptBool(false), ptString(verbosity.OutputMinimal), nil, "")
+		assert.ErrorAs(t, err, &ErrReadOnly{})
+		vectorRepo.AssertNotCalled(t, "BatchDeleteObjects", mock.Anything)
+	})
+}
+
+func Test_ReadOnly_DeprecatedDeleteChecksCollection(t *testing.T) {
+	var (
+		id       = strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+		readOnly = errors.New("writes are rejected: write mode of collection Foo is READ_ONLY")
+	)
+
+	vectorRepo := &fakeVectorRepo{}
+	schemaManager := &fakeSchemaManager{readOnlyClasses: map[string]error{"Foo": readOnly}}
+	logger, _ := test.NewNullLogger()
+	manager := NewManager(schemaManager, &config.WeaviateConfig{}, logger, mocks.NewMockAuthorizer(),
+		vectorRepo, getFakeModulesProvider(), &fakeMetrics{}, nil)
+
+	vectorRepo.On("ObjectByID", id, mock.Anything, mock.Anything).
+		Return(&search.Result{ID: id, ClassName: "Foo"}, nil).Once()
+
+	err := manager.DeleteObject(context.Background(), nil, "", id, nil, "")
+	assert.ErrorAs(t, err, &ErrReadOnly{})
+	assert.ErrorIs(t, err, readOnly)
+	vectorRepo.AssertNotCalled(t, "DeleteObject", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -16,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/weaviate/weaviate/entities/search"
 	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
@@ -52,7 +53,7 @@ func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Prin
 			return &Error{err.Error(), StatusForbidden, err}
 		}
 		objectRes, err := m.getObjectFromRepo(ctx, "", input.ID,
-			additional.Properties{}, nil, tenant)
+			search.SelectProperties{}, additional.Properties{}, nil, tenant)
 		if err != nil {
 			errnf := ErrNotFound{} // treated as StatusBadRequest for backward comp
 			if errors.As(err, &errnf) {
@@ -69,6 +70,10 @@ func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Prin
 		return &Error{err.Error(), StatusBadRequest, err}
 	}
 
+	if err := m.schemaManager.CheckDataWrite(input.Class); err != nil {
+		return &Error{err.Error(), StatusUnprocessableEntity, NewErrReadOnly(err)}
+	}
+
 	class, schemaVersion, fetchedClass, typedErr := m.getAuthorizedFromClass(ctx, principal, input.Class)
 	if typedErr != nil {
 		return typedErr
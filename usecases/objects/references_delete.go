@@ -17,6 +17,7 @@ import (
 	"fmt"
 
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 
 	"github.com/go-openapi/strfmt"
@@ -63,7 +64,7 @@ func (m *Manager) DeleteObjectReference(ctx context.Context, principal *models.P
 		if err := m.authorizer.Authorize(principal, authorization.READ, authorization.CollectionsData()...); err != nil {
 			return &Error{err.Error(), StatusForbidden, err}
 		}
-		res, err := m.getObjectFromRepo(ctx, input.Class, input.ID, additional.Properties{}, nil, tenant)
+		res, err := m.getObjectFromRepo(ctx, input.Class, input.ID, search.SelectProperties{}, additional.Properties{}, nil, tenant)
 		if err != nil {
 			errnf := ErrNotFound{}
 			if errors.As(err, &errnf) {
@@ -81,12 +82,16 @@ func (m *Manager) DeleteObjectReference(ctx context.Context, principal *models.P
 		return &Error{err.Error(), StatusBadRequest, err}
 	}
 
+	if err := m.schemaManager.CheckDataWrite(input.Class); err != nil {
+		return &Error{err.Error(), StatusUnprocessableEntity, NewErrReadOnly(err)}
+	}
+
 	class, schemaVersion, _, typedErr := m.getAuthorizedFromClass(ctx, principal, input.Class)
 	if typedErr != nil {
 		return typedErr
 	}
 
-	res, err := m.getObjectFromRepo(ctx, input.Class, input.ID, additional.Properties{}, nil, tenant)
+	res, err := m.getObjectFromRepo(ctx, input.Class, input.ID, search.SelectProperties{}, additional.Properties{}, nil, tenant)
 	if err != nil {
 		errnf := ErrNotFound{}
 		if errors.As(err, &errnf) {
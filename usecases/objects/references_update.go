@@ -16,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 
 	"github.com/go-openapi/strfmt"
@@ -62,7 +63,7 @@ func (m *Manager) UpdateObjectReferences(ctx context.Context, principal *models.
 		}
 	}
 
-	res, err := m.getObjectFromRepo(ctx, input.Class, input.ID, additional.Properties{}, nil, tenant)
+	res, err := m.getObjectFromRepo(ctx, input.Class, input.ID, search.SelectProperties{}, additional.Properties{}, nil, tenant)
 	if err != nil {
 		errnf := ErrNotFound{}
 		if errors.As(err, &errnf) {
@@ -81,6 +82,10 @@ func (m *Manager) UpdateObjectReferences(ctx context.Context, principal *models.
 		return &Error{err.Error(), StatusBadRequest, err}
 	}
 
+	if err := m.schemaManager.CheckDataWrite(input.Class); err != nil {
+		return &Error{err.Error(), StatusUnprocessableEntity, NewErrReadOnly(err)}
+	}
+
 	class, schemaVersion, _, typedErr := m.getAuthorizedFromClass(ctx, principal, input.Class)
 	if typedErr != nil {
 		return typedErr
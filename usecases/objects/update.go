@@ -16,6 +16,7 @@ import (
 	"fmt"
 
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/versioned"
 
 	"github.com/go-openapi/strfmt"
@@ -41,6 +42,10 @@ func (m *Manager) UpdateObject(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	if err := m.schemaManager.CheckDataWrite(className); err != nil {
+		return nil, NewErrReadOnly(err)
+	}
+
 	ctx = classcache.ContextWithClassCache(ctx)
 	// we don't reveal any info that the end users cannot get through the structure of the data anyway
 	fetchedClasses, err := m.schemaManager.GetCachedClassNoAuth(ctx, className)
@@ -67,7 +72,7 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 		return nil, NewErrInvalidUserInput("invalid update: field 'id' is immutable")
 	}
 
-	obj, err := m.getObjectFromRepo(ctx, className, id, additional.Properties{}, repl, updates.Tenant)
+	obj, err := m.getObjectFromRepo(ctx, className, id, search.SelectProperties{}, additional.Properties{}, repl, updates.Tenant)
 	if err != nil {
 		return nil, err
 	}
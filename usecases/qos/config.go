@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package qos
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+var validateName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`).MatchString
+
+// Class is a quality of service class, e.g. "interactive" or "batch". A
+// principal belongs to the first class that lists its username or one of its
+// roles. Queries of classes with a higher priority are admitted first when
+// queries have to wait for a free slot.
+type Class struct {
+	Name     string `json:"name" yaml:"name"`
+	Priority int    `json:"priority" yaml:"priority"`
+	// MaxConcurrentQueries limits the number of queries of this class that
+	// run at the same time, 0 means that only the overall limit applies
+	MaxConcurrentQueries int `json:"maxConcurrentQueries" yaml:"maxConcurrentQueries"`
+	// MaxQueuedQueries limits the number of queries of this class waiting for
+	// a free slot, further queries are rejected. 0 means unlimited.
+	MaxQueuedQueries int `json:"maxQueuedQueries" yaml:"maxQueuedQueries"`
+	// QueueTimeout is the maximum time a query waits for a free slot, 0
+	// means it waits until the request is cancelled
+	QueueTimeout time.Duration `json:"queueTimeout" yaml:"queueTimeout"`
+	// QueryTimeout is the maximum time a query may run once admitted, 0
+	// means no limit
+	QueryTimeout time.Duration `json:"queryTimeout" yaml:"queryTimeout"`
+
+	// Users are usernames, such as the user an API key belongs to
+	Users []string `json:"users" yaml:"users"`
+	Roles []string `json:"roles" yaml:"roles"`
+}
+
+func (c Class) Validate() error {
+	if !validateName(c.Name) {
+		return fmt.Errorf("name %q must start with a letter and only contain "+
+			"letters, digits, '_' and '-'", c.Name)
+	}
+	if c.MaxConcurrentQueries < 0 {
+		return fmt.Errorf("maxConcurrentQueries must not be negative")
+	}
+	if c.MaxQueuedQueries < 0 {
+		return fmt.Errorf("maxQueuedQueries must not be negative")
+	}
+	if c.QueueTimeout < 0 {
+		return fmt.Errorf("queueTimeout must not be negative")
+	}
+	if c.QueryTimeout < 0 {
+		return fmt.Errorf("queryTimeout must not be negative")
+	}
+	return nil
+}
+
+// Config defines the QoS classes of this node. Principals that don't belong
+// to any class are assigned to the Default class. If Default isn't set, they
+// are scheduled with priority 0 and only the overall limit applies.
+type Config struct {
+	// MaxConcurrentQueries is the number of queries that run at the same
+	// time across all classes, 0 means unlimited
+	MaxConcurrentQueries int     `json:"maxConcurrentQueries" yaml:"maxConcurrentQueries"`
+	Default              string  `json:"default" yaml:"default"`
+	Classes              []Class `json:"classes" yaml:"classes"`
+}
+
+func (c Config) Enabled() bool {
+	return len(c.Classes) > 0
+}
+
+func (c Config) Validate() error {
+	if c.MaxConcurrentQueries < 0 {
+		return fmt.Errorf("qos: maxConcurrentQueries must not be negative")
+	}
+
+	seen := map[string]struct{}{}
+	for i, class := range c.Classes {
+		if err := class.Validate(); err != nil {
+			return fmt.Errorf("qos.classes[%d]: %w", i, err)
+		}
+
+		if _, ok := seen[class.Name]; ok {
+			return fmt.Errorf("qos.classes[%d]: duplicate class %q", i, class.Name)
+		}
+		seen[class.Name] = struct{}{}
+	}
+
+	if _, ok := seen[c.Default]; c.Default != "" && !ok {
+		return fmt.Errorf("qos: default class %q is not defined", c.Default)
+	}
+
+	return nil
+}
+
+// LoadConfig reads the QoS classes from a yaml file
+func LoadConfig(path string) (Config, error) {
+	var cfg Config
+
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, fmt.Errorf("read qos config: %w", err)
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(buf))
+	dec.SetStrict(true)
+	// an empty file is valid and simply does not define any classes
+	if err := dec.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+		return cfg, fmt.Errorf("parse qos config: %w", err)
+	}
+
+	return cfg, cfg.Validate()
+}
@@ -0,0 +1,338 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package qos schedules queries by the quality of service class of the
+// principal that sends them. Classes limit how many queries run concurrently
+// and how long they may wait and run. When queries have to wait for a free
+// slot, the ones of the class with the highest priority are admitted first,
+// so that e.g. analytics jobs don't degrade the latency of interactive
+// searches.
+package qos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+var (
+	ErrQueueFull    = errors.New("query queue is full")
+	ErrQueueTimeout = errors.New("timed out waiting for a free query slot")
+)
+
+// IsRejected returns whether err is caused by a query that wasn't admitted by
+// its QoS class. Other rate limits, like the limit of concurrent Get queries,
+// don't count.
+func IsRejected(err error) bool {
+	return errors.Is(err, ErrQueueFull) || errors.Is(err, ErrQueueTimeout)
+}
+
+const (
+	// classCacheTTL is how long the class of a principal is cached, so that
+	// its roles aren't resolved for every query. A change of the roles of a
+	// principal takes effect after at most this long.
+	classCacheTTL = time.Minute
+	// maxCachedPrincipals bounds the cache, expired classes are dropped once
+	// it is full
+	maxCachedPrincipals = 10_000
+)
+
+type roleGetter interface {
+	GetRoleNamesForPrincipal(principal *models.Principal) ([]string, error)
+}
+
+type class struct {
+	Class
+	running int
+	queued  int
+}
+
+type waiter struct {
+	class    *class
+	admitted chan struct{}
+}
+
+type cachedClass struct {
+	class   *class
+	expires time.Time
+}
+
+// Scheduler admits queries according to the QoS class of their principal. A
+// nil Scheduler admits every query immediately.
+type Scheduler struct {
+	maxConcurrent int
+	classes       []*class
+	defaultClass  *class
+	roles         roleGetter
+	logger        logrus.FieldLogger
+
+	cacheMu sync.Mutex
+	cache   map[string]cachedClass
+	now     func() time.Time
+
+	mu      sync.Mutex
+	running int
+	// waiting is ordered by priority, queries of the same priority are
+	// admitted in the order they arrived
+	waiting []*waiter
+}
+
+// New creates a Scheduler for the given config. The roles of a principal are
+// looked up through roles, which resolves them through RBAC if it is enabled.
+// If roles is nil, the groups of the principal are used as its roles.
+func New(cfg Config, roles roleGetter, logger logrus.FieldLogger) *Scheduler {
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	s := &Scheduler{
+		maxConcurrent: cfg.MaxConcurrentQueries,
+		classes:       make([]*class, len(cfg.Classes)),
+		defaultClass:  &class{Class: Class{Name: "default"}},
+		roles:         roles,
+		logger:        logger,
+		cache:         map[string]cachedClass{},
+		now:           time.Now,
+	}
+	for i := range cfg.Classes {
+		s.classes[i] = &class{Class: cfg.Classes[i]}
+		if cfg.Classes[i].Name == cfg.Default {
+			s.defaultClass = s.classes[i]
+		}
+	}
+	return s
+}
+
+// Admit blocks until the principal may run another query. The returned
+// context is cancelled once the query timeout of the class is reached, release
+// must be called when the query is done. If the query can't be admitted, an
+// enterrors.ErrRateLimit wrapping ErrQueueFull or ErrQueueTimeout or the
+// error of ctx is returned.
+func (s *Scheduler) Admit(ctx context.Context, principal *models.Principal) (context.Context, func(), error) {
+	if s == nil {
+		return ctx, func() {}, nil
+	}
+
+	c := s.classOf(principal)
+
+	s.mu.Lock()
+	if s.canRun(c) {
+		s.start(c)
+		s.mu.Unlock()
+		return s.admitted(ctx, c)
+	}
+
+	if c.MaxQueuedQueries > 0 && c.queued >= c.MaxQueuedQueries {
+		s.mu.Unlock()
+		return nil, nil, enterrors.NewErrRateLimitf("%w: qos class %q", ErrQueueFull, c.Name)
+	}
+
+	w := &waiter{class: c, admitted: make(chan struct{})}
+	s.enqueue(w)
+	s.mu.Unlock()
+
+	var timeout <-chan time.Time
+	if c.QueueTimeout > 0 {
+		timer := time.NewTimer(c.QueueTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	var err error
+	select {
+	case <-w.admitted:
+		return s.admitted(ctx, c)
+	case <-ctx.Done():
+		err = ctx.Err()
+	case <-timeout:
+		err = enterrors.NewErrRateLimitf("%w after %s: qos class %q", ErrQueueTimeout, c.QueueTimeout, c.Name)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.dequeue(w) {
+		// the query was admitted while giving up, hand the slot on
+		s.finish(c)
+	}
+	return nil, nil, err
+}
+
+func (s *Scheduler) admitted(ctx context.Context, c *class) (context.Context, func(), error) {
+	cancel := func() {}
+	if c.QueryTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.QueryTimeout)
+	}
+
+	once := sync.Once{}
+	release := func() {
+		once.Do(func() {
+			cancel()
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.finish(c)
+		})
+	}
+	return ctx, release, nil
+}
+
+func (s *Scheduler) canRun(c *class) bool {
+	if s.maxConcurrent > 0 && s.running >= s.maxConcurrent {
+		return false
+	}
+	return c.MaxConcurrentQueries <= 0 || c.running < c.MaxConcurrentQueries
+}
+
+func (s *Scheduler) start(c *class) {
+	s.running++
+	c.running++
+}
+
+// finish frees the slot of a query of class c and admits as many waiting
+// queries as possible. A waiting query is skipped if its own class is at its
+// limit, so a busy class doesn't block other classes.
+func (s *Scheduler) finish(c *class) {
+	s.running--
+	c.running--
+
+	for i := 0; i < len(s.waiting); {
+		if s.maxConcurrent > 0 && s.running >= s.maxConcurrent {
+			return
+		}
+
+		w := s.waiting[i]
+		if !s.canRun(w.class) {
+			i++
+			continue
+		}
+
+		s.waiting = slices.Delete(s.waiting, i, i+1)
+		w.class.queued--
+		s.start(w.class)
+		close(w.admitted)
+	}
+}
+
+func (s *Scheduler) enqueue(w *waiter) {
+	i := slices.IndexFunc(s.waiting, func(other *waiter) bool {
+		return other.class.Priority < w.class.Priority
+	})
+	if i < 0 {
+		i = len(s.waiting)
+	}
+	s.waiting = slices.Insert(s.waiting, i, w)
+	w.class.queued++
+}
+
+// dequeue removes w from the waiting queries. It returns false if w isn't
+// waiting anymore, because it was admitted in the meantime.
+func (s *Scheduler) dequeue(w *waiter) bool {
+	i := slices.Index(s.waiting, w)
+	if i < 0 {
+		return false
+	}
+	s.waiting = slices.Delete(s.waiting, i, i+1)
+	w.class.queued--
+	return true
+}
+
+// classOf returns the class of principal, which is cached for classCacheTTL
+func (s *Scheduler) classOf(principal *models.Principal) *class {
+	key := classCacheKey(principal)
+	now := s.now()
+
+	s.cacheMu.Lock()
+	cached, ok := s.cache[key]
+	s.cacheMu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.class
+	}
+
+	c, err := s.resolveClass(principal)
+	if err != nil {
+		// the principal is scheduled with the default class until its roles
+		// can be resolved
+		s.logger.WithField("action", "qos").WithError(err).
+			Warn("could not resolve roles of principal for qos class")
+		return s.defaultClass
+	}
+
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+	if len(s.cache) >= maxCachedPrincipals {
+		for k, cached := range s.cache {
+			if !now.Before(cached.expires) {
+				delete(s.cache, k)
+			}
+		}
+		if len(s.cache) >= maxCachedPrincipals {
+			s.cache = map[string]cachedClass{}
+		}
+	}
+	s.cache[key] = cachedClass{class: c, expires: now.Add(classCacheTTL)}
+	return c
+}
+
+func (s *Scheduler) resolveClass(principal *models.Principal) (*class, error) {
+	var roles []string
+	resolved := false
+	for _, c := range s.classes {
+		if principal != nil && slices.Contains(c.Users, principal.Username) {
+			return c, nil
+		}
+		if len(c.Roles) == 0 {
+			continue
+		}
+
+		if !resolved {
+			var err error
+			if roles, err = s.principalRoles(principal); err != nil {
+				return nil, err
+			}
+			resolved = true
+		}
+		for _, role := range c.Roles {
+			if slices.Contains(roles, role) {
+				return c, nil
+			}
+		}
+	}
+
+	return s.defaultClass, nil
+}
+
+// principalRoles resolves the roles of principal like its permissions,
+// including the roles of its groups and those of the anonymous subject
+func (s *Scheduler) principalRoles(principal *models.Principal) ([]string, error) {
+	if s.roles == nil {
+		if principal == nil {
+			return nil, nil
+		}
+		return principal.Groups, nil
+	}
+	return s.roles.GetRoleNamesForPrincipal(principal)
+}
+
+func classCacheKey(principal *models.Principal) string {
+	if principal == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s:%s\x00%s", principal.UserType, principal.Username,
+		strings.Join(principal.Groups, "\x00"))
+}
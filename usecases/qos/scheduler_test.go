@@ -0,0 +1,324 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package qos
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// fakeRoles holds the roles of users and groups, the roles of the
+// anonymous subject are stored for the empty name
+type fakeRoles struct {
+	roles  map[string][]string
+	groups map[string][]string
+	err    error
+	calls  int
+}
+
+func (f *fakeRoles) GetRoleNamesForPrincipal(principal *models.Principal) ([]string, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	if principal == nil {
+		return f.roles[""], nil
+	}
+	out := append([]string{}, f.roles[principal.Username]...)
+	for _, g := range principal.Groups {
+		out = append(out, f.groups[g]...)
+	}
+	return out, nil
+}
+
+var (
+	interactive = &models.Principal{Username: "search-frontend"}
+	batch       = &models.Principal{Username: "nightly-job"}
+)
+
+func newScheduler(t *testing.T, cfg Config) *Scheduler {
+	t.Helper()
+	require.Nil(t, cfg.Validate())
+	logger, _ := test.NewNullLogger()
+	return New(cfg, nil, logger)
+}
+
+func TestSchedulerClassOf(t *testing.T) {
+	cfg := Config{
+		Default: "interactive",
+		Classes: []Class{
+			{Name: "batch", Users: []string{"nightly-job"}, Roles: []string{"analytics"}},
+			{Name: "interactive", Priority: 10},
+		},
+	}
+	logger, _ := test.NewNullLogger()
+	roles := &fakeRoles{
+		roles:  map[string][]string{"reporting": {"analytics"}},
+		groups: map[string][]string{"data-team": {"analytics"}},
+	}
+	s := New(cfg, roles, logger)
+
+	assert.Equal(t, "batch", s.classOf(batch).Name)
+	assert.Equal(t, "batch", s.classOf(&models.Principal{Username: "reporting"}).Name)
+	assert.Equal(t, "batch", s.classOf(&models.Principal{Username: "x", Groups: []string{"data-team"}}).Name)
+	assert.Equal(t, "interactive", s.classOf(interactive).Name)
+	assert.Equal(t, "interactive", s.classOf(nil).Name)
+
+	t.Run("anonymous roles", func(t *testing.T) {
+		anonymous := New(cfg, &fakeRoles{roles: map[string][]string{"": {"analytics"}}}, logger)
+		assert.Equal(t, "batch", anonymous.classOf(nil).Name)
+	})
+
+	t.Run("classes are cached", func(t *testing.T) {
+		roles := &fakeRoles{roles: map[string][]string{"reporting": {"analytics"}}}
+		cached := New(cfg, roles, logger)
+		now := time.Now()
+		cached.now = func() time.Time { return now }
+		reporting := &models.Principal{Username: "reporting"}
+
+		assert.Equal(t, "batch", cached.classOf(reporting).Name)
+		assert.Equal(t, "batch", cached.classOf(reporting).Name)
+		assert.Equal(t, 1, roles.calls)
+
+		// other groups are a different principal
+		cached.classOf(&models.Principal{Username: "reporting", Groups: []string{"data-team"}})
+		assert.Equal(t, 2, roles.calls)
+
+		// a change of roles takes effect once the class expired
+		roles.roles["reporting"] = nil
+		now = now.Add(classCacheTTL)
+		assert.Equal(t, "interactive", cached.classOf(reporting).Name)
+		assert.Equal(t, 3, roles.calls)
+	})
+
+	t.Run("roles can't be resolved", func(t *testing.T) {
+		roles := &fakeRoles{err: errors.New("boom")}
+		failing := New(cfg, roles, logger)
+		assert.Equal(t, "interactive", failing.classOf(&models.Principal{Username: "reporting"}).Name)
+
+		// the default class isn't cached, the roles are resolved again
+		assert.Equal(t, "interactive", failing.classOf(&models.Principal{Username: "reporting"}).Name)
+		assert.Equal(t, 2, roles.calls)
+	})
+
+	t.Run("groups are used as roles without RBAC", func(t *testing.T) {
+		noRBAC := New(cfg, nil, logger)
+		assert.Equal(t, "batch", noRBAC.classOf(&models.Principal{Username: "x", Groups: []string{"analytics"}}).Name)
+	})
+
+	t.Run("implicit default class", func(t *testing.T) {
+		noDefault := New(Config{Classes: cfg.Classes}, nil, logger)
+		assert.Equal(t, "default", noDefault.classOf(interactive).Name)
+	})
+}
+
+func TestSchedulerPriority(t *testing.T) {
+	s := newScheduler(t, Config{
+		MaxConcurrentQueries: 1,
+		Classes: []Class{
+			{Name: "interactive", Priority: 10, Users: []string{"search-frontend"}},
+			{Name: "batch", Users: []string{"nightly-job"}},
+		},
+	})
+	ctx := context.Background()
+
+	_, release, err := s.Admit(ctx, batch)
+	require.Nil(t, err)
+
+	// the batch query waits first, but the interactive query is admitted
+	// before it once the slot is free
+	admitted := make(chan string, 2)
+	releases := make(chan func(), 2)
+	admit := func(principal *models.Principal) {
+		_, release, err := s.Admit(ctx, principal)
+		if err == nil {
+			admitted <- principal.Username
+			releases <- release
+		}
+	}
+	go admit(batch)
+	require.Eventually(t, func() bool { return queued(s) == 1 }, time.Second, time.Millisecond)
+	go admit(interactive)
+	require.Eventually(t, func() bool { return queued(s) == 2 }, time.Second, time.Millisecond)
+
+	release()
+	assert.Equal(t, "search-frontend", <-admitted)
+	(<-releases)()
+	assert.Equal(t, "nightly-job", <-admitted)
+	(<-releases)()
+
+	assert.Equal(t, 0, s.running)
+}
+
+func TestSchedulerClassLimit(t *testing.T) {
+	s := newScheduler(t, Config{
+		Classes: []Class{
+			{Name: "batch", MaxConcurrentQueries: 1, MaxQueuedQueries: 1, Users: []string{"nightly-job"}},
+		},
+	})
+	ctx := context.Background()
+
+	_, release, err := s.Admit(ctx, batch)
+	require.Nil(t, err)
+
+	// other classes are not limited by a busy class
+	_, releaseOther, err := s.Admit(ctx, interactive)
+	require.Nil(t, err)
+	releaseOther()
+
+	done := make(chan error)
+	go func() {
+		_, release, err := s.Admit(ctx, batch)
+		if err == nil {
+			release()
+		}
+		done <- err
+	}()
+	require.Eventually(t, func() bool { return queued(s) == 1 }, time.Second, time.Millisecond)
+
+	_, _, err = s.Admit(ctx, batch)
+	assert.ErrorIs(t, err, ErrQueueFull)
+	assert.ErrorAs(t, err, &enterrors.ErrRateLimit{})
+
+	release()
+	assert.Nil(t, <-done)
+}
+
+func TestSchedulerTimeouts(t *testing.T) {
+	s := newScheduler(t, Config{
+		Classes: []Class{{
+			Name:                 "batch",
+			MaxConcurrentQueries: 1,
+			QueueTimeout:         10 * time.Millisecond,
+			QueryTimeout:         time.Minute,
+			Users:                []string{"nightly-job"},
+		}},
+	})
+
+	queryCtx, release, err := s.Admit(context.Background(), batch)
+	require.Nil(t, err)
+	_, ok := queryCtx.Deadline()
+	assert.True(t, ok)
+
+	_, _, err = s.Admit(context.Background(), batch)
+	assert.ErrorIs(t, err, ErrQueueTimeout)
+	assert.ErrorAs(t, err, &enterrors.ErrRateLimit{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _, err = s.Admit(ctx, batch)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	release()
+	release()
+	assert.ErrorIs(t, queryCtx.Err(), context.Canceled)
+	assert.Equal(t, 0, s.running)
+	assert.Equal(t, 0, queued(s))
+}
+
+func TestNilScheduler(t *testing.T) {
+	var s *Scheduler
+	ctx, release, err := s.Admit(context.Background(), batch)
+	require.Nil(t, err)
+	assert.NotNil(t, ctx)
+	release()
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("valid", func(t *testing.T) {
+		path := filepath.Join(dir, "valid.yaml")
+		require.Nil(t, os.WriteFile(path, []byte(`
+maxConcurrentQueries: 16
+default: interactive
+classes:
+- name: interactive
+  priority: 10
+- name: batch
+  maxConcurrentQueries: 4
+  queueTimeout: 30s
+  queryTimeout: 5m
+  users: [nightly-job]
+  roles: [analytics]
+`), 0o600))
+
+		cfg, err := LoadConfig(path)
+		require.Nil(t, err)
+		assert.Equal(t, 16, cfg.MaxConcurrentQueries)
+		require.Len(t, cfg.Classes, 2)
+		assert.Equal(t, 30*time.Second, cfg.Classes[1].QueueTimeout)
+		assert.Equal(t, 5*time.Minute, cfg.Classes[1].QueryTimeout)
+		assert.Equal(t, []string{"analytics"}, cfg.Classes[1].Roles)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		path := filepath.Join(dir, "empty.yaml")
+		require.Nil(t, os.WriteFile(path, nil, 0o600))
+
+		cfg, err := LoadConfig(path)
+		require.Nil(t, err)
+		assert.False(t, cfg.Enabled())
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			cfg      Config
+			expected string
+		}{
+			{
+				name:     "invalid name",
+				cfg:      Config{Classes: []Class{{Name: "1st"}}},
+				expected: "must start with a letter",
+			},
+			{
+				name:     "negative limit",
+				cfg:      Config{Classes: []Class{{Name: "batch", MaxConcurrentQueries: -1}}},
+				expected: "must not be negative",
+			},
+			{
+				name:     "duplicate class",
+				cfg:      Config{Classes: []Class{{Name: "batch"}, {Name: "batch"}}},
+				expected: "duplicate class",
+			},
+			{
+				name:     "unknown default",
+				cfg:      Config{Default: "interactive", Classes: []Class{{Name: "batch"}}},
+				expected: "is not defined",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assert.ErrorContains(t, tt.cfg.Validate(), tt.expected)
+			})
+		}
+	})
+}
+
+func queued(s *Scheduler) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.waiting)
+}
@@ -0,0 +1,203 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package querytemplates
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/tailor-inc/graphql/language/parser"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+var (
+	validateName     = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`).MatchString
+	validateVariable = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`).MatchString
+)
+
+// SearchValidator checks that search is a valid search request of the gRPC
+// API in its JSON representation. It's called with all variables removed
+// from the request.
+type SearchValidator func(search json.RawMessage) error
+
+func validate(t command.QueryTemplate, validateSearch SearchValidator) error {
+	if !validateName(t.Name) {
+		return fmt.Errorf("name %q must start with a letter and only contain "+
+			"letters, digits, '_' and '-'", t.Name)
+	}
+
+	switch {
+	case t.Query != "" && len(t.Search) > 0:
+		return fmt.Errorf("only one of query and search must be set")
+	case t.Query != "":
+		return validateQuery(t.Query)
+	case len(t.Search) > 0:
+		search, err := stripVariables(t.Search)
+		if err != nil {
+			return err
+		}
+		if validateSearch == nil {
+			return nil
+		}
+		if err := validateSearch(search); err != nil {
+			return fmt.Errorf("invalid search: %w", err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("query or search must be set")
+	}
+}
+
+func validateQuery(query string) error {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return fmt.Errorf("parse query: %w", err)
+	}
+
+	// the template is executed without an operation name, so it must not
+	// contain more than one operation
+	operations := 0
+	for _, def := range doc.Definitions {
+		if _, ok := def.(*ast.OperationDefinition); ok {
+			operations++
+		}
+	}
+	if operations != 1 {
+		return fmt.Errorf("query must contain exactly one operation, got %d", operations)
+	}
+
+	return nil
+}
+
+// ResolveSearch returns the search request of the template with its
+// variables replaced by their values. A variable is a string value of the form
+// "$name" anywhere in the request, it's replaced by the value of the variable
+// as is, so it may be of any type. A string value starting with "$$" stands
+// for the same string with a single leading "$". All variables of the
+// template must be set and no other variables may be given.
+func ResolveSearch(t command.QueryTemplate, variables map[string]interface{}) (json.RawMessage, error) {
+	if len(t.Search) == 0 {
+		return nil, fmt.Errorf("query template %q has no search", t.Name)
+	}
+
+	used := map[string]struct{}{}
+	resolved, err := substituteJSON(t.Search, func(name string) (interface{}, bool, error) {
+		value, ok := variables[name]
+		if !ok {
+			return nil, false, fmt.Errorf("variable %q of query template %q is not set", name, t.Name)
+		}
+		used[name] = struct{}{}
+		return value, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var unknown []string
+	for name := range variables {
+		if _, ok := used[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("query template %q has no variables %q", t.Name, unknown)
+	}
+
+	return resolved, nil
+}
+
+// stripVariables removes all fields and list items which are set to a
+// variable, so that the rest of the search can be validated
+func stripVariables(search json.RawMessage) (json.RawMessage, error) {
+	return substituteJSON(search, func(string) (interface{}, bool, error) {
+		return nil, false, nil
+	})
+}
+
+// substituteJSON replaces all variables in the JSON object search with the
+// result of replace, or drops them if replace doesn't return a value
+func substituteJSON(search json.RawMessage,
+	replace func(name string) (interface{}, bool, error),
+) (json.RawMessage, error) {
+	dec := json.NewDecoder(bytes.NewReader(search))
+	// keep numbers as they are, int64 fields must not lose precision
+	dec.UseNumber()
+	var decoded interface{}
+	if err := dec.Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("parse search: %w", err)
+	}
+	if _, ok := decoded.(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("search must be a JSON object")
+	}
+
+	substituted, _, err := substitute(decoded, replace)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(substituted)
+}
+
+func substitute(v interface{},
+	replace func(name string) (interface{}, bool, error),
+) (interface{}, bool, error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			substituted, ok, err := substitute(value, replace)
+			if err != nil {
+				return nil, false, err
+			}
+			if ok {
+				out[key] = substituted
+			}
+		}
+		return out, true, nil
+
+	case []interface{}:
+		out := make([]interface{}, 0, len(v))
+		for _, value := range v {
+			substituted, ok, err := substitute(value, replace)
+			if err != nil {
+				return nil, false, err
+			}
+			if ok {
+				out = append(out, substituted)
+			}
+		}
+		return out, true, nil
+
+	case string:
+		if strings.HasPrefix(v, "$$") {
+			return v[1:], true, nil
+		}
+		if !strings.HasPrefix(v, "$") {
+			return v, true, nil
+		}
+		name := v[1:]
+		if !validateVariable(name) {
+			return nil, false, fmt.Errorf("invalid variable %q, variables must start with "+
+				"a letter or '_' and only contain letters, digits and '_', use \"$$\" for a leading '$'", v)
+		}
+		return replace(name)
+
+	default:
+		return v, true, nil
+	}
+}
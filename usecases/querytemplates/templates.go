@@ -0,0 +1,273 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package querytemplates manages named, parameterized queries that are
+// executed by name. A template holds either a GraphQL query, which is executed
+// over REST, or a search request of the gRPC API, which is executed through
+// the ExecuteTemplate method.
+//
+// Templates are stored in the schema, so they are replicated to all nodes and
+// survive restarts. Every change assigns a new version to the template, which
+// clients can pin when executing it. Managing templates requires permissions
+// to update the cluster. Who may execute a template is controlled through its
+// roles. Executing a template does not grant any additional permissions, the
+// query still runs with the permissions of the caller.
+package querytemplates
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/sirupsen/logrus"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+var (
+	ErrNotFound  = errors.New("query template not found")
+	ErrExists    = errors.New("query template already exists")
+	ErrVersion   = errors.New("query template version mismatch")
+	ErrInvalid   = errors.New("invalid query template")
+	ErrForbidden = errors.New("not allowed to execute query template")
+)
+
+type schemaManager interface {
+	UpsertQueryTemplate(ctx context.Context, req *command.UpsertQueryTemplateRequest) (uint64, error)
+	DeleteQueryTemplate(ctx context.Context, name string) (uint64, error)
+}
+
+type schemaReader interface {
+	QueryTemplate(name string) (command.QueryTemplate, bool)
+	QueryTemplates() []command.QueryTemplate
+	// WaitForUpdate ensures that the local schema has caught up to version
+	WaitForUpdate(ctx context.Context, version uint64) error
+}
+
+type roleGetter interface {
+	GetRoleNamesForPrincipal(principal *models.Principal) ([]string, error)
+}
+
+// Templates manages the query templates stored in the schema
+type Templates struct {
+	schemaManager  schemaManager
+	schemaReader   schemaReader
+	authorizer     authorization.Authorizer
+	roles          roleGetter
+	validateSearch SearchValidator
+	logger         logrus.FieldLogger
+}
+
+// New creates Templates. The roles of a principal are looked up through
+// roles, which resolves them through RBAC if it is enabled. If roles is nil,
+// the groups of the principal are used as its roles. The search requests of
+// templates are checked with validateSearch before they are stored.
+func New(schemaManager schemaManager, schemaReader schemaReader,
+	authorizer authorization.Authorizer, roles roleGetter,
+	validateSearch SearchValidator, logger logrus.FieldLogger,
+) *Templates {
+	return &Templates{
+		schemaManager:  schemaManager,
+		schemaReader:   schemaReader,
+		authorizer:     authorizer,
+		roles:          roles,
+		validateSearch: validateSearch,
+		logger:         logger,
+	}
+}
+
+// List returns the templates the principal may execute, sorted by name.
+// Principals which may manage templates get all of them.
+func (t *Templates) List(principal *models.Principal) []command.QueryTemplate {
+	all := t.schemaReader.QueryTemplates()
+	if t.authorizer.AuthorizeSilent(principal, authorization.UPDATE, authorization.Cluster()) == nil {
+		return all
+	}
+
+	roles, err := t.principalRoles(principal)
+	if err != nil {
+		t.logRolesErr(err)
+	}
+
+	out := make([]command.QueryTemplate, 0, len(all))
+	for _, tmpl := range all {
+		if allows(tmpl, roles, err) {
+			out = append(out, tmpl)
+		}
+	}
+	return out
+}
+
+// Get returns the template with the given name if the principal may execute
+// it. It returns ErrNotFound if there is no such template and ErrForbidden if
+// the principal does not hold any of the roles of the template. If version is
+// not zero, it returns ErrVersion unless the template has this version.
+func (t *Templates) Get(principal *models.Principal, name string, version uint64) (command.QueryTemplate, error) {
+	tmpl, ok := t.schemaReader.QueryTemplate(name)
+	if !ok {
+		return command.QueryTemplate{}, fmt.Errorf("%w: %q", ErrNotFound, name)
+	}
+
+	roles, err := t.principalRoles(principal)
+	if err != nil {
+		t.logRolesErr(err)
+	}
+	if !allows(tmpl, roles, err) {
+		return command.QueryTemplate{}, fmt.Errorf("%w: %q", ErrForbidden, name)
+	}
+
+	// pinning a version makes sure a client doesn't silently run a query
+	// that changed since it was written against it
+	if version != 0 && version != tmpl.Version {
+		return command.QueryTemplate{}, fmt.Errorf("%w: %q has version %d, but version %d was requested",
+			ErrVersion, name, tmpl.Version, version)
+	}
+
+	return tmpl, nil
+}
+
+// Create adds a new template and returns it with its version
+func (t *Templates) Create(ctx context.Context, principal *models.Principal,
+	tmpl command.QueryTemplate,
+) (command.QueryTemplate, error) {
+	if err := t.authorizer.Authorize(principal, authorization.UPDATE, authorization.Cluster()); err != nil {
+		return command.QueryTemplate{}, err
+	}
+	if err := validate(tmpl, t.validateSearch); err != nil {
+		return command.QueryTemplate{}, fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+	if _, ok := t.schemaReader.QueryTemplate(tmpl.Name); ok {
+		return command.QueryTemplate{}, fmt.Errorf("%w: %q", ErrExists, tmpl.Name)
+	}
+
+	return t.upsert(ctx, &command.UpsertQueryTemplateRequest{Template: tmpl, Create: true})
+}
+
+// Update replaces an existing template and returns it with its new version.
+// If expectedVersion is not zero, the template is only updated if it still
+// has this version, otherwise ErrVersion is returned.
+func (t *Templates) Update(ctx context.Context, principal *models.Principal,
+	tmpl command.QueryTemplate, expectedVersion uint64,
+) (command.QueryTemplate, error) {
+	if err := t.authorizer.Authorize(principal, authorization.UPDATE, authorization.Cluster()); err != nil {
+		return command.QueryTemplate{}, err
+	}
+	if err := validate(tmpl, t.validateSearch); err != nil {
+		return command.QueryTemplate{}, fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+	current, ok := t.schemaReader.QueryTemplate(tmpl.Name)
+	if !ok {
+		return command.QueryTemplate{}, fmt.Errorf("%w: %q", ErrNotFound, tmpl.Name)
+	}
+	if expectedVersion != 0 && expectedVersion != current.Version {
+		return command.QueryTemplate{}, fmt.Errorf("%w: %q has version %d, expected %d",
+			ErrVersion, tmpl.Name, current.Version, expectedVersion)
+	}
+
+	return t.upsert(ctx, &command.UpsertQueryTemplateRequest{
+		Template:        tmpl,
+		ExpectedVersion: expectedVersion,
+	})
+}
+
+// Delete removes a template
+func (t *Templates) Delete(ctx context.Context, principal *models.Principal, name string) error {
+	if err := t.authorizer.Authorize(principal, authorization.UPDATE, authorization.Cluster()); err != nil {
+		return err
+	}
+	if _, ok := t.schemaReader.QueryTemplate(name); !ok {
+		return fmt.Errorf("%w: %q", ErrNotFound, name)
+	}
+
+	version, err := t.schemaManager.DeleteQueryTemplate(ctx, name)
+	if err != nil {
+		return clusterErr(err)
+	}
+	return t.schemaReader.WaitForUpdate(ctx, version)
+}
+
+func (t *Templates) upsert(ctx context.Context, req *command.UpsertQueryTemplateRequest) (command.QueryTemplate, error) {
+	// the version is assigned when the change is applied
+	req.Template.Version = 0
+
+	version, err := t.schemaManager.UpsertQueryTemplate(ctx, req)
+	if err != nil {
+		return command.QueryTemplate{}, clusterErr(err)
+	}
+
+	// make sure the change is in effect on this node before returning, so
+	// that executing the template on this node runs the new version
+	if err := t.schemaReader.WaitForUpdate(ctx, version); err != nil {
+		return command.QueryTemplate{}, err
+	}
+
+	tmpl := req.Template
+	tmpl.Version = version
+	return tmpl, nil
+}
+
+// clusterErr maps the errors of concurrent changes, which are only detected
+// when the change is applied
+func clusterErr(err error) error {
+	switch {
+	case errors.Is(err, clusterSchema.ErrQueryTemplateExists):
+		return fmt.Errorf("%w: %w", ErrExists, err)
+	case errors.Is(err, clusterSchema.ErrQueryTemplateNotFound):
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	case errors.Is(err, clusterSchema.ErrQueryTemplateVersion):
+		return fmt.Errorf("%w: %w", ErrVersion, err)
+	default:
+		return err
+	}
+}
+
+func (t *Templates) principalRoles(principal *models.Principal) ([]string, error) {
+	if t.roles == nil {
+		if principal == nil {
+			return nil, nil
+		}
+		return principal.Groups, nil
+	}
+
+	// roles are resolved like permissions, including those of the groups of
+	// the principal and those of the anonymous subject
+	roles, err := t.roles.GetRoleNamesForPrincipal(principal)
+	if err != nil {
+		return nil, fmt.Errorf("get roles for principal: %w", err)
+	}
+	return roles, nil
+}
+
+func (t *Templates) logRolesErr(err error) {
+	// fail closed: if we cannot tell which roles the principal holds, only
+	// templates without any role restriction can be executed
+	t.logger.WithField("action", "query_templates").WithError(err).
+		Warn("could not resolve roles of principal, denying restricted query templates")
+}
+
+func allows(tmpl command.QueryTemplate, roles []string, rolesErr error) bool {
+	if len(tmpl.Roles) == 0 {
+		return true
+	}
+	if rolesErr != nil {
+		return false
+	}
+	for _, role := range tmpl.Roles {
+		if slices.Contains(roles, role) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,405 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package querytemplates
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+// fakeRoles holds the roles of users and groups, the roles of the
+// anonymous subject are stored for the empty name
+type fakeRoles struct {
+	roles  map[string][]string
+	groups map[string][]string
+	err    error
+}
+
+func (f *fakeRoles) GetRoleNamesForPrincipal(principal *models.Principal) ([]string, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if principal == nil {
+		return f.roles[""], nil
+	}
+	out := append([]string{}, f.roles[principal.Username]...)
+	for _, g := range principal.Groups {
+		out = append(out, f.groups[g]...)
+	}
+	return out, nil
+}
+
+// fakeAuthorizer allows everything to the admin user and nothing else
+type fakeAuthorizer struct{}
+
+func (fakeAuthorizer) Authorize(principal *models.Principal, verb string, resources ...string) error {
+	if principal != nil && principal.Username == "admin" {
+		return nil
+	}
+	return authzerrors.NewForbidden(principal, verb, resources...)
+}
+
+func (a fakeAuthorizer) AuthorizeSilent(principal *models.Principal, verb string, resources ...string) error {
+	return a.Authorize(principal, verb, resources...)
+}
+
+func (a fakeAuthorizer) FilterAuthorizedResources(principal *models.Principal, verb string, resources ...string) ([]string, error) {
+	if err := a.Authorize(principal, verb, resources...); err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// fakeSchema applies changes right away, the version is a counter of all
+// changes
+type fakeSchema struct {
+	version   uint64
+	templates map[string]command.QueryTemplate
+}
+
+func newFakeSchema(templates ...command.QueryTemplate) *fakeSchema {
+	f := &fakeSchema{templates: map[string]command.QueryTemplate{}}
+	for _, t := range templates {
+		f.version++
+		t.Version = f.version
+		f.templates[t.Name] = t
+	}
+	return f
+}
+
+func (f *fakeSchema) UpsertQueryTemplate(ctx context.Context, req *command.UpsertQueryTemplateRequest) (uint64, error) {
+	f.version++
+	t := req.Template
+	t.Version = f.version
+	f.templates[t.Name] = t
+	return f.version, nil
+}
+
+func (f *fakeSchema) DeleteQueryTemplate(ctx context.Context, name string) (uint64, error) {
+	f.version++
+	delete(f.templates, name)
+	return f.version, nil
+}
+
+func (f *fakeSchema) QueryTemplate(name string) (command.QueryTemplate, bool) {
+	t, ok := f.templates[name]
+	return t, ok
+}
+
+func (f *fakeSchema) QueryTemplates() []command.QueryTemplate {
+	out := make([]command.QueryTemplate, 0, len(f.templates))
+	for _, t := range f.templates {
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+func (f *fakeSchema) WaitForUpdate(ctx context.Context, version uint64) error {
+	if version > f.version {
+		return fmt.Errorf("version %d was never applied", version)
+	}
+	return nil
+}
+
+const productSearch = `query($text: String!) {
+  Get { Product(hybrid: {query: $text, alpha: 0.5}, limit: 10) { name } }
+}`
+
+func TestTemplates(t *testing.T) {
+	roles := &fakeRoles{
+		roles: map[string][]string{
+			"shop-user":  {"shop"},
+			"other-user": {"viewer"},
+		},
+		groups: map[string][]string{"shop-team": {"shop"}},
+	}
+	logger, _ := test.NewNullLogger()
+	newTemplates := func(roles roleGetter) *Templates {
+		schema := newFakeSchema(
+			command.QueryTemplate{Name: "productSearch", Query: productSearch, Roles: []string{"shop"}},
+			command.QueryTemplate{Name: "allProducts", Query: `{ Get { Product { name } } }`},
+		)
+		return New(schema, schema, fakeAuthorizer{}, roles, nil, logger)
+	}
+	templates := newTemplates(roles)
+
+	t.Run("principal with role", func(t *testing.T) {
+		principal := &models.Principal{Username: "shop-user"}
+
+		list := templates.List(principal)
+		require.Len(t, list, 2)
+		assert.Equal(t, "allProducts", list[0].Name)
+		assert.Equal(t, "productSearch", list[1].Name)
+
+		tmpl, err := templates.Get(principal, "productSearch", 0)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(1), tmpl.Version)
+	})
+
+	t.Run("principal without role", func(t *testing.T) {
+		principal := &models.Principal{Username: "other-user"}
+
+		list := templates.List(principal)
+		require.Len(t, list, 1)
+		assert.Equal(t, "allProducts", list[0].Name)
+
+		_, err := templates.Get(principal, "productSearch", 0)
+		assert.ErrorIs(t, err, ErrForbidden)
+
+		_, err = templates.Get(principal, "allProducts", 0)
+		assert.Nil(t, err)
+	})
+
+	t.Run("principal with role through group", func(t *testing.T) {
+		principal := &models.Principal{Username: "other-user", Groups: []string{"shop-team"}}
+
+		assert.Len(t, templates.List(principal), 2)
+		_, err := templates.Get(principal, "productSearch", 0)
+		assert.Nil(t, err)
+	})
+
+	t.Run("managers list all templates", func(t *testing.T) {
+		assert.Len(t, templates.List(&models.Principal{Username: "admin"}), 2)
+	})
+
+	t.Run("anonymous principal", func(t *testing.T) {
+		_, err := templates.Get(nil, "productSearch", 0)
+		assert.ErrorIs(t, err, ErrForbidden)
+
+		anonymous := newTemplates(&fakeRoles{roles: map[string][]string{"": {"shop"}}})
+		_, err = anonymous.Get(nil, "productSearch", 0)
+		assert.Nil(t, err)
+	})
+
+	t.Run("unknown template", func(t *testing.T) {
+		_, err := templates.Get(&models.Principal{Username: "shop-user"}, "missing", 0)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("pinned version", func(t *testing.T) {
+		principal := &models.Principal{Username: "shop-user"}
+
+		_, err := templates.Get(principal, "productSearch", 1)
+		assert.Nil(t, err)
+		_, err = templates.Get(principal, "productSearch", 2)
+		assert.ErrorIs(t, err, ErrVersion)
+	})
+
+	t.Run("roles can't be resolved", func(t *testing.T) {
+		failing := newTemplates(&fakeRoles{err: errors.New("boom")})
+		principal := &models.Principal{Username: "shop-user"}
+
+		_, err := failing.Get(principal, "productSearch", 0)
+		assert.ErrorIs(t, err, ErrForbidden)
+		assert.Len(t, failing.List(principal), 1)
+	})
+
+	t.Run("groups are used as roles without RBAC", func(t *testing.T) {
+		noRBAC := newTemplates(nil)
+
+		_, err := noRBAC.Get(&models.Principal{Username: "x", Groups: []string{"shop"}}, "productSearch", 0)
+		assert.Nil(t, err)
+
+		_, err = noRBAC.Get(&models.Principal{Username: "x"}, "productSearch", 0)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestManageTemplates(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	admin := &models.Principal{Username: "admin"}
+	ctx := context.Background()
+	search := command.QueryTemplate{
+		Name:   "search",
+		Search: json.RawMessage(`{"collection":"Product","limit":"$limit"}`),
+	}
+	newTemplates := func(validateSearch SearchValidator) *Templates {
+		schema := newFakeSchema(command.QueryTemplate{Name: "allProducts", Query: `{ Get { Product { name } } }`})
+		return New(schema, schema, fakeAuthorizer{}, nil, validateSearch, logger)
+	}
+
+	t.Run("create", func(t *testing.T) {
+		var validated json.RawMessage
+		templates := newTemplates(func(search json.RawMessage) error {
+			validated = search
+			return nil
+		})
+
+		created, err := templates.Create(ctx, admin, search)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(2), created.Version)
+		assert.JSONEq(t, `{"collection":"Product"}`, string(validated))
+
+		_, err = templates.Create(ctx, admin, search)
+		assert.ErrorIs(t, err, ErrExists)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		templates := newTemplates(nil)
+		update := command.QueryTemplate{Name: "allProducts", Query: `{ Get { Product { name price } } }`}
+
+		_, err := templates.Update(ctx, admin, update, 5)
+		assert.ErrorIs(t, err, ErrVersion)
+
+		updated, err := templates.Update(ctx, admin, update, 1)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(2), updated.Version)
+
+		updated, err = templates.Update(ctx, admin, update, 0)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(3), updated.Version)
+
+		tmpl, err := templates.Get(admin, "allProducts", 3)
+		require.Nil(t, err)
+		assert.Equal(t, update.Query, tmpl.Query)
+
+		_, err = templates.Update(ctx, admin, search, 0)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		templates := newTemplates(nil)
+
+		require.Nil(t, templates.Delete(ctx, admin, "allProducts"))
+		_, err := templates.Get(admin, "allProducts", 0)
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorIs(t, templates.Delete(ctx, admin, "allProducts"), ErrNotFound)
+	})
+
+	t.Run("requires permissions", func(t *testing.T) {
+		templates := newTemplates(nil)
+		principal := &models.Principal{Username: "shop-user"}
+
+		_, err := templates.Create(ctx, principal, search)
+		assert.ErrorAs(t, err, &authzerrors.Forbidden{})
+		_, err = templates.Update(ctx, principal, command.QueryTemplate{Name: "allProducts", Query: productSearch}, 0)
+		assert.ErrorAs(t, err, &authzerrors.Forbidden{})
+		assert.ErrorAs(t, templates.Delete(ctx, principal, "allProducts"), &authzerrors.Forbidden{})
+	})
+
+	t.Run("invalid templates", func(t *testing.T) {
+		templates := newTemplates(func(json.RawMessage) error { return errors.New("unknown field") })
+
+		tests := []struct {
+			name     string
+			template command.QueryTemplate
+			expected string
+		}{
+			{
+				name:     "invalid name",
+				template: command.QueryTemplate{Name: "1st", Query: productSearch},
+				expected: "must start with a letter",
+			},
+			{
+				name:     "neither query nor search",
+				template: command.QueryTemplate{Name: "empty"},
+				expected: "query or search must be set",
+			},
+			{
+				name:     "query and search",
+				template: command.QueryTemplate{Name: "both", Query: productSearch, Search: search.Search},
+				expected: "only one of query and search",
+			},
+			{
+				name:     "invalid query",
+				template: command.QueryTemplate{Name: "broken", Query: "{ Get { Product { name } "},
+				expected: "parse query",
+			},
+			{
+				name: "multiple operations",
+				template: command.QueryTemplate{
+					Name: "multi", Query: `query A { Get { Product { name } } } query B { Get { Product { name } } }`,
+				},
+				expected: "exactly one operation",
+			},
+			{
+				name:     "search isn't an object",
+				template: command.QueryTemplate{Name: "list", Search: json.RawMessage(`[]`)},
+				expected: "search must be a JSON object",
+			},
+			{
+				name:     "invalid variable",
+				template: command.QueryTemplate{Name: "price", Search: json.RawMessage(`{"collection":"$5"}`)},
+				expected: `invalid variable "$5"`,
+			},
+			{
+				name:     "invalid search",
+				template: search,
+				expected: "invalid search: unknown field",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := templates.Create(ctx, admin, tt.template)
+				assert.ErrorIs(t, err, ErrInvalid)
+				assert.ErrorContains(t, err, tt.expected)
+			})
+		}
+	})
+}
+
+func TestResolveSearch(t *testing.T) {
+	tmpl := command.QueryTemplate{
+		Name: "search",
+		Search: json.RawMessage(`{
+			"collection": "Product",
+			"limit": "$limit",
+			"bm25_search": {"query": "$text", "properties": ["name", "$$name"]},
+			"filters": {"operator": "OPERATOR_EQUAL", "target": {"property": "sku"}, "value_int": 9007199254740993}
+		}`),
+	}
+
+	t.Run("all variables set", func(t *testing.T) {
+		resolved, err := ResolveSearch(tmpl, map[string]interface{}{
+			"limit": 10,
+			"text":  "shoes",
+		})
+		require.Nil(t, err)
+		assert.JSONEq(t, `{
+			"collection": "Product",
+			"limit": 10,
+			"bm25_search": {"query": "shoes", "properties": ["name", "$name"]},
+			"filters": {"operator": "OPERATOR_EQUAL", "target": {"property": "sku"}, "value_int": 9007199254740993}
+		}`, string(resolved))
+		// JSONEq compares numbers as floats
+		assert.Contains(t, string(resolved), "9007199254740993")
+	})
+
+	t.Run("missing variable", func(t *testing.T) {
+		_, err := ResolveSearch(tmpl, map[string]interface{}{"limit": 10})
+		assert.ErrorContains(t, err, `variable "text" of query template "search" is not set`)
+	})
+
+	t.Run("unknown variable", func(t *testing.T) {
+		_, err := ResolveSearch(tmpl, map[string]interface{}{"limit": 10, "text": "shoes", "alpha": 0.5})
+		assert.ErrorContains(t, err, `query template "search" has no variables ["alpha"]`)
+	})
+
+	t.Run("GraphQL template", func(t *testing.T) {
+		_, err := ResolveSearch(command.QueryTemplate{Name: "gql", Query: productSearch}, nil)
+		assert.ErrorContains(t, err, "has no search")
+	})
+}
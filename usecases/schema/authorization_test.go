@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
@@ -124,6 +125,17 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:      authorization.READ,
 			expectedResources: authorization.ShardsMetadata("className", "P1"),
 		},
+		{
+			methodName:        "UpdateWriteMode",
+			additionalArgs:    []interface{}{"className", command.WriteModeReadOnly},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: []string{authorization.Cluster()},
+		},
+		{
+			methodName:        "GetWriteModes",
+			expectedVerb:      authorization.READ,
+			expectedResources: []string{authorization.Cluster()},
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
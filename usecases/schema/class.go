@@ -593,6 +593,10 @@ func (h *Handler) validateProperty(
 			return err
 		}
 
+		if err := validatePropertyTextAnalyzer(property, propertyDataType); err != nil {
+			return err
+		}
+
 		if err := h.validatePropertyIndexing(property); err != nil {
 			return err
 		}
@@ -726,6 +730,21 @@ func (h *Handler) validatePropertyTokenization(tokenization string, propertyData
 	return fmt.Errorf("Tokenization is not allowed for reference data type")
 }
 
+// validatePropertyTextAnalyzer allows text analyzers only for text and
+// text[] properties, as they are the only ones being tokenized
+func validatePropertyTextAnalyzer(prop *models.Property, propertyDataType schema.PropertyDataType) error {
+	if prop.TextAnalyzer == nil {
+		return nil
+	}
+	if propertyDataType.IsPrimitive() {
+		switch propertyDataType.AsPrimitive() {
+		case schema.DataTypeText, schema.DataTypeTextArray:
+			return nil
+		}
+	}
+	return fmt.Errorf("property '%s': textAnalyzer is only allowed for text and text[] data types", prop.Name)
+}
+
 func (h *Handler) validatePropertyIndexing(prop *models.Property) error {
 	if prop.IndexInverted != nil {
 		if prop.IndexFilterable != nil || prop.IndexSearchable != nil || prop.IndexRangeFilters != nil {
@@ -385,6 +385,44 @@ func Test_AddClass(t *testing.T) {
 		})
 	})
 
+	t.Run("with text analyzer", func(t *testing.T) {
+		analyzer := &models.TextAnalyzerConfig{ASCIIFold: true, CaseFold: true}
+
+		t.Run("text property", func(t *testing.T) {
+			handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+			fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
+			fakeSchemaManager.On("QueryCollectionsCount").Return(0, nil)
+
+			_, _, err := handler.AddClass(ctx, nil, &models.Class{
+				Class:      "NewClass",
+				Vectorizer: "none",
+				Properties: []*models.Property{{
+					Name:         "city",
+					DataType:     schema.DataTypeText.PropString(),
+					Tokenization: models.PropertyTokenizationField,
+					TextAnalyzer: analyzer,
+				}},
+			})
+			require.Nil(t, err)
+			fakeSchemaManager.AssertExpectations(t)
+		})
+
+		t.Run("non text property", func(t *testing.T) {
+			handler, _ := newTestHandler(t, &fakeDB{})
+
+			_, _, err := handler.AddClass(ctx, nil, &models.Class{
+				Class:      "NewClass",
+				Vectorizer: "none",
+				Properties: []*models.Property{{
+					Name:         "population",
+					DataType:     schema.DataTypeInt.PropString(),
+					TextAnalyzer: analyzer,
+				}},
+			})
+			assert.EqualError(t, err, "property 'population': textAnalyzer is only allowed for text and text[] data types")
+		})
+	})
+
 	t.Run("with invalid settings", func(t *testing.T) {
 		handler, _ := newTestHandler(t, &fakeDB{})
 
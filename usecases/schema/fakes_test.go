@@ -86,6 +86,19 @@ func (f *fakeSchemaManager) Remove(ctx context.Context, nodeID string) error {
 	return args.Error(0)
 }
 
+func (f *fakeSchemaManager) UpdateWriteMode(_ context.Context, collection string, mode command.WriteMode) (uint64, error) {
+	args := f.Called(collection, mode)
+	return 0, args.Error(0)
+}
+
+func (f *fakeSchemaManager) WriteModes() (command.WriteMode, map[string]command.WriteMode) {
+	return command.WriteModeReadWrite, map[string]command.WriteMode{}
+}
+
+func (f *fakeSchemaManager) CheckDataWrite(class string) error {
+	return nil
+}
+
 func (f *fakeSchemaManager) Stats() map[string]any {
 	return map[string]any{}
 }
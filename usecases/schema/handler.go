@@ -54,6 +54,7 @@ type SchemaManager interface {
 	AddTenants(ctx context.Context, class string, req *command.AddTenantsRequest) (uint64, error)
 	UpdateTenants(ctx context.Context, class string, req *command.UpdateTenantsRequest) (uint64, error)
 	DeleteTenants(ctx context.Context, class string, req *command.DeleteTenantsRequest) (uint64, error)
+	UpdateWriteMode(ctx context.Context, collection string, mode command.WriteMode) (uint64, error)
 
 	// Cluster related operations
 	Join(_ context.Context, nodeID, raftAddr string, voter bool) error
@@ -94,6 +95,8 @@ type SchemaReader interface {
 	ShardOwner(class, shard string) (string, error)
 	Read(class string, reader func(*models.Class, *sharding.State) error) error
 	GetShardsStatus(class, tenant string) (models.ShardStatusList, error)
+	WriteModes() (command.WriteMode, map[string]command.WriteMode)
+	CheckDataWrite(class string) error
 
 	// These schema reads function (...WithVersion) return the metadata once the local schema has caught up to the
 	// version parameter. If version is 0 is behaves exactly the same as eventual consistent reads.
@@ -23,6 +23,8 @@ import (
 	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	modsloadazure "github.com/weaviate/weaviate/modules/offload-azure"
+	modsloadgcs "github.com/weaviate/weaviate/modules/offload-gcs"
 	modsloads3 "github.com/weaviate/weaviate/modules/offload-s3"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/filter"
@@ -99,6 +101,18 @@ func validateTenants(tenants []*models.Tenant, allowOverHundred bool) (validated
 	return
 }
 
+// offloadModules are all modules which can store FROZEN tenants
+var offloadModules = []string{modsloads3.Name, modsloadgcs.Name, modsloadazure.Name}
+
+func (h *Handler) offloadModuleEnabled() bool {
+	for _, name := range offloadModules {
+		if h.moduleConfig.GetByName(name) != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func (h *Handler) validateActivityStatuses(ctx context.Context, tenants []*models.Tenant,
 	allowEmpty, allowFrozen bool,
 ) error {
@@ -110,9 +124,10 @@ func (h *Handler) validateActivityStatuses(ctx context.Context, tenants []*model
 		case models.TenantActivityStatusHOT, models.TenantActivityStatusCOLD:
 			continue
 		case models.TenantActivityStatusFROZEN:
-			if mod := h.moduleConfig.GetByName(modsloads3.Name); mod == nil {
+			if !h.offloadModuleEnabled() {
 				return fmt.Errorf(
-					"can't offload tenants, because offload-s3 module is not enabled")
+					"can't offload tenants, because no offload module (%s) is enabled",
+					strings.Join(offloadModules, ", "))
 			}
 
 			if allowFrozen && h.cloud != nil {
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// WriteModes is the cluster-wide write mode and the write modes of all
+// collections which are not read-write
+type WriteModes struct {
+	Cluster     command.WriteMode            `json:"cluster"`
+	Collections map[string]command.WriteMode `json:"collections"`
+}
+
+// UpdateWriteMode sets the write mode of collection, or the cluster-wide
+// write mode if collection is empty. Write modes are an admin switch for
+// migrations and incident containment, so they require cluster permissions
+// even for a single collection.
+func (h *Handler) UpdateWriteMode(ctx context.Context, principal *models.Principal,
+	collection string, mode command.WriteMode,
+) error {
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.Cluster())
+	if err != nil {
+		return err
+	}
+
+	if err := mode.Validate(); err != nil {
+		return err
+	}
+	if collection != "" {
+		collection = schema.UppercaseClassName(collection)
+	}
+
+	version, err := h.schemaManager.UpdateWriteMode(ctx, collection, mode)
+	if err != nil {
+		return err
+	}
+
+	// make sure the mode is in effect on this node before returning, so that
+	// a following write to this node is handled accordingly
+	return h.schemaReader.WaitForUpdate(ctx, version)
+}
+
+func (h *Handler) GetWriteModes(ctx context.Context, principal *models.Principal) (WriteModes, error) {
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.Cluster())
+	if err != nil {
+		return WriteModes{}, err
+	}
+
+	cluster, collections := h.schemaReader.WriteModes()
+	return WriteModes{Cluster: cluster, Collections: collections}, nil
+}
@@ -146,6 +146,10 @@ func (e *Explorer) GetClass(ctx context.Context,
 		return nil, errors.Wrap(err, "cursor api: invalid 'after' parameter")
 	}
 
+	if err := e.validateKeywordSearch(params); err != nil {
+		return nil, errors.Wrap(err, "invalid keyword search")
+	}
+
 	if params.KeywordRanking != nil {
 		res, err := e.getClassKeywordBased(ctx, params)
 		if err != nil {
@@ -621,7 +625,12 @@ func (e *Explorer) CrossClassVectorSearch(ctx context.Context,
 		return nil, errors.Errorf("vectorize params: %v", err)
 	}
 
-	res, err := e.searcher.CrossClassVectorSearch(ctx, vector, targetVector, params.Offset, params.Limit, nil)
+	var res []search.Result
+	if len(params.Collections) > 0 {
+		res, err = e.federatedVectorSearch(ctx, vector, targetVector, params)
+	} else {
+		res, err = e.searcher.CrossClassVectorSearch(ctx, vector, targetVector, params.Offset, params.Limit, nil)
+	}
 	if err != nil {
 		return nil, errors.Errorf("vector search: %v", err)
 	}
@@ -664,7 +673,7 @@ func (e *Explorer) validateExploreParams(params ExploreParams) error {
 			"or module search params is required for an exploration")
 	}
 
-	return nil
+	return validateExploreCollections(params.Collections)
 }
 
 func (e *Explorer) targetFromParams(ctx context.Context,
@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+// federatedVectorSearch searches each of the requested collections with the
+// same vector and merges the hits by their weighted similarity, so that the
+// hits of a collection with a higher weight rank higher
+func (e *Explorer) federatedVectorSearch(ctx context.Context, vector models.Vector,
+	targetVector string, params ExploreParams,
+) ([]search.Result, error) {
+	targetVectors := make([][]string, len(params.Collections))
+	similarities := make([]func(dist float32) float32, len(params.Collections))
+	for i, collection := range params.Collections {
+		if collection.TargetVector != "" {
+			targetVectors[i] = []string{collection.TargetVector}
+		} else if targetVector != "" {
+			targetVectors[i] = []string{targetVector}
+		}
+
+		similarity, err := e.federatedSimilarity(collection.Name, targetVectors[i])
+		if err != nil {
+			return nil, fmt.Errorf("collection %q: %w", collection.Name, err)
+		}
+		similarities[i] = similarity
+	}
+
+	resultSets := make([][]search.Result, len(params.Collections))
+
+	eg := enterrors.NewErrorGroupWrapper(e.logger)
+	for i, collection := range params.Collections {
+		i, collection := i, collection
+		eg.Go(func() error {
+			res, err := e.searcher.VectorSearch(ctx, dto.GetParams{
+				ClassName:  collection.Name,
+				Tenant:     collection.Tenant,
+				Pagination: &filters.Pagination{Limit: params.Offset + params.Limit},
+			}, targetVectors[i], []models.Vector{vector})
+			if err != nil {
+				return fmt.Errorf("collection %q: %w", collection.Name, err)
+			}
+
+			for j := range res {
+				res[j].ClassName = collection.Name
+				res[j].Score = float32(collection.Weight) * similarities[i](res[j].Dist)
+			}
+			resultSets[i] = res
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	var merged []search.Result
+	for _, res := range resultSets {
+		merged = append(merged, res...)
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Score > merged[j].Score
+	})
+
+	if params.Offset >= len(merged) {
+		return []search.Result{}, nil
+	}
+	merged = merged[params.Offset:]
+	if len(merged) > params.Limit {
+		merged = merged[:params.Limit]
+	}
+	return merged, nil
+}
+
+// federatedSimilarity returns how the distances of the vector index of a
+// collection map to a similarity between 0 and 1, which the weight of the
+// collection is applied to. Dot product distances are unbounded and may be
+// negative, so they can't be weighted.
+func (e *Explorer) federatedSimilarity(className string, targetVectors []string,
+) (func(dist float32) float32, error) {
+	class := e.schemaGetter.ReadOnlyClass(className)
+	if class == nil {
+		return nil, fmt.Errorf("collection not found")
+	}
+	vectorConfig, err := schemaConfig.TypeAssertVectorIndex(class, targetVectors)
+	if err != nil {
+		return nil, err
+	}
+
+	switch distType := vectorConfig[0].DistanceName(); distType {
+	case common.DistanceCosine:
+		return func(dist float32) float32 {
+			return float32(additional.DistToCertainty(float64(dist)))
+		}, nil
+	case common.DistanceL2Squared, common.DistanceManhattan, common.DistanceHamming:
+		return func(dist float32) float32 {
+			return 1 / (1 + dist)
+		}, nil
+	default:
+		return nil, fmt.Errorf("weighted collections are not supported with %s distance", distType)
+	}
+}
+
+func validateExploreCollections(collections []ExploreCollection) error {
+	seen := make(map[string]struct{}, len(collections))
+	for _, collection := range collections {
+		if collection.Name == "" {
+			return errors.Errorf("collection name cannot be empty")
+		}
+		if _, ok := seen[collection.Name]; ok {
+			return errors.Errorf("collection %q is listed more than once", collection.Name)
+		}
+		seen[collection.Name] = struct{}{}
+		if collection.Weight <= 0 {
+			return errors.Errorf("weight of collection %q must be greater than 0", collection.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_ExploreFederated(t *testing.T) {
+	newTraverser := func(vectorSearcher *fakeVectorSearcher) *Traverser {
+		logger, _ := test.NewNullLogger()
+		metrics := &fakeMetrics{}
+		metrics.On("AddUsageDimensions", "n/a", "explore_graphql", "nearVector", 0)
+		explorer := NewExplorer(vectorSearcher, logger, getFakeModulesProvider(), metrics, defaultConfig)
+		schemaGetter := &fakeSchemaGetter{schema: schema.Schema{Objects: &models.Schema{
+			Classes: []*models.Class{
+				{Class: "Articles", VectorIndexConfig: hnsw.UserConfig{Distance: "cosine"}},
+				{Class: "Videos", VectorIndexConfig: hnsw.UserConfig{Distance: "cosine"}},
+				{Class: "Logs", VectorIndexConfig: hnsw.UserConfig{Distance: "l2-squared"}},
+				{Class: "Metrics", VectorIndexConfig: hnsw.UserConfig{Distance: "l2-squared"}},
+				{Class: "Embeddings", VectorIndexConfig: hnsw.UserConfig{Distance: "dot"}},
+			},
+		}}}
+		explorer.SetSchemaGetter(schemaGetter)
+		return NewTraverser(&config.WeaviateConfig{}, logger, mocks.NewMockAuthorizer(),
+			vectorSearcher, explorer, schemaGetter, nil, nil, -1)
+	}
+	vector := []float32{1, 2, 3}
+	expectedParams := func(className string) dto.GetParams {
+		return dto.GetParams{ClassName: className, Pagination: &filters.Pagination{Limit: 3}}
+	}
+
+	t.Run("merges the collections by weighted score", func(t *testing.T) {
+		vectorSearcher := &fakeVectorSearcher{}
+		vectorSearcher.On("VectorSearch", expectedParams("Articles"), []models.Vector{vector}).
+			Return([]search.Result{{ID: "a1", Dist: 0.1}, {ID: "a2", Dist: 0.6}}, nil)
+		vectorSearcher.On("VectorSearch", expectedParams("Videos"), []models.Vector{vector}).
+			Return([]search.Result{{ID: "v1", Dist: 0.2}, {ID: "v2", Dist: 0.3}}, nil)
+
+		res, err := newTraverser(vectorSearcher).Explore(context.Background(), nil, ExploreParams{
+			NearVector: &searchparams.NearVector{Vectors: []models.Vector{vector}},
+			Limit:      3,
+			Collections: []ExploreCollection{
+				{Name: "Articles", Weight: 1},
+				{Name: "Videos", Weight: 2},
+			},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+
+		// cosine distances are weighted as certainty, 1 - dist/2
+		assert.Equal(t, "Videos", res[0].ClassName)
+		assert.Equal(t, "weaviate://localhost/Videos/v1", res[0].Beacon)
+		assert.InDelta(t, 1.8, res[0].Score, 1e-6)
+		assert.Equal(t, "Videos", res[1].ClassName)
+		assert.InDelta(t, 1.7, res[1].Score, 1e-6)
+		assert.Equal(t, "Articles", res[2].ClassName)
+		assert.InDelta(t, 0.95, res[2].Score, 1e-6)
+	})
+
+	t.Run("merges l2-squared collections by weighted score", func(t *testing.T) {
+		logsParams := expectedParams("Logs")
+		logsParams.Tenant = "tenant1"
+
+		vectorSearcher := &fakeVectorSearcher{}
+		vectorSearcher.On("VectorSearch", logsParams, []models.Vector{vector}).
+			Return([]search.Result{{ID: "l1", Dist: 1}, {ID: "l2", Dist: 3}}, nil)
+		vectorSearcher.On("VectorSearch", expectedParams("Metrics"), []models.Vector{vector}).
+			Return([]search.Result{{ID: "m1", Dist: 4}}, nil)
+
+		res, err := newTraverser(vectorSearcher).Explore(context.Background(), nil, ExploreParams{
+			NearVector: &searchparams.NearVector{Vectors: []models.Vector{vector}},
+			Limit:      3,
+			Collections: []ExploreCollection{
+				{Name: "Logs", Weight: 1, Tenant: "tenant1"},
+				{Name: "Metrics", Weight: 2},
+			},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+
+		// l2-squared distances are weighted as 1 / (1 + dist)
+		assert.Equal(t, "weaviate://localhost/Logs/l1", res[0].Beacon)
+		assert.InDelta(t, 0.5, res[0].Score, 1e-6)
+		assert.Equal(t, "weaviate://localhost/Metrics/m1", res[1].Beacon)
+		assert.InDelta(t, 0.4, res[1].Score, 1e-6)
+		assert.Equal(t, "weaviate://localhost/Logs/l2", res[2].Beacon)
+		assert.InDelta(t, 0.25, res[2].Score, 1e-6)
+	})
+
+	t.Run("with dot product distance", func(t *testing.T) {
+		_, err := newTraverser(&fakeVectorSearcher{}).Explore(context.Background(), nil, ExploreParams{
+			NearVector:  &searchparams.NearVector{Vectors: []models.Vector{vector}},
+			Collections: []ExploreCollection{{Name: "Embeddings", Weight: 1}},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "weighted collections are not supported with dot distance")
+	})
+
+	t.Run("ignores distance metrics of other collections", func(t *testing.T) {
+		vectorSearcher := &fakeVectorSearcher{}
+		vectorSearcher.On("VectorSearch", expectedParams("Articles"), []models.Vector{vector}).
+			Return([]search.Result{}, nil)
+
+		_, err := newTraverser(vectorSearcher).Explore(context.Background(), nil, ExploreParams{
+			NearVector:  &searchparams.NearVector{Vectors: []models.Vector{vector}},
+			Limit:       3,
+			Collections: []ExploreCollection{{Name: "Articles", Weight: 1}},
+		})
+		require.Nil(t, err)
+	})
+
+	t.Run("with incompatible distance metrics", func(t *testing.T) {
+		_, err := newTraverser(&fakeVectorSearcher{}).Explore(context.Background(), nil, ExploreParams{
+			NearVector: &searchparams.NearVector{Vectors: []models.Vector{vector}},
+			Collections: []ExploreCollection{
+				{Name: "Articles", Weight: 1},
+				{Name: "Logs", Weight: 1},
+			},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "found different distance metrics")
+	})
+
+	t.Run("with unknown collection", func(t *testing.T) {
+		_, err := newTraverser(&fakeVectorSearcher{}).Explore(context.Background(), nil, ExploreParams{
+			NearVector:  &searchparams.NearVector{Vectors: []models.Vector{vector}},
+			Collections: []ExploreCollection{{Name: "Podcasts", Weight: 1}},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), `collection "Podcasts" not found`)
+	})
+
+	t.Run("with invalid weight", func(t *testing.T) {
+		_, err := newTraverser(&fakeVectorSearcher{}).Explore(context.Background(), nil, ExploreParams{
+			NearVector:  &searchparams.NearVector{Vectors: []models.Vector{vector}},
+			Collections: []ExploreCollection{{Name: "Articles", Weight: 0}},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), `weight of collection "Articles" must be greater than 0`)
+	})
+}
@@ -33,9 +33,11 @@ import (
 // Do a bm25 search.  The results will be used in the hybrid algorithm
 func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*search.Result, string, error) {
 	params.KeywordRanking = &searchparams.KeywordRanking{
-		Query:      params.HybridSearch.Query,
-		Type:       "bm25",
-		Properties: params.HybridSearch.Properties,
+		Query:          params.HybridSearch.Query,
+		Type:           "bm25",
+		Properties:     params.HybridSearch.Properties,
+		Stopwords:      params.HybridSearch.Stopwords,
+		SearchOperator: params.HybridSearch.SearchOperator,
 	}
 
 	params.Group = nil
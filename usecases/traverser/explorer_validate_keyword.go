@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// validateKeywordSearch checks the search operator and the query-time
+// stopword overrides of bm25 and hybrid searches
+func (e *Explorer) validateKeywordSearch(params dto.GetParams) error {
+	if params.KeywordRanking != nil {
+		if err := validateKeywordSearch(params.KeywordRanking.Stopwords,
+			params.KeywordRanking.SearchOperator); err != nil {
+			return err
+		}
+	}
+	if params.HybridSearch != nil {
+		if err := validateKeywordSearch(params.HybridSearch.Stopwords,
+			params.HybridSearch.SearchOperator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateKeywordSearch(stopwordConfig *models.StopwordConfig,
+	operator searchparams.KeywordOperator,
+) error {
+	if err := operator.Validate(); err != nil {
+		return err
+	}
+	if stopwordConfig != nil {
+		if _, err := stopwords.NewDetectorFromConfig(*stopwordConfig); err != nil {
+			return fmt.Errorf("stopwords: %w", err)
+		}
+	}
+	return nil
+}
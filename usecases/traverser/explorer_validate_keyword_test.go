@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func TestValidateKeywordStopwords(t *testing.T) {
+	tests := []struct {
+		name      string
+		stopwords *models.StopwordConfig
+		wantErr   string
+	}{
+		{name: "none"},
+		{name: "keep stopwords", stopwords: &models.StopwordConfig{Preset: "none"}},
+		{
+			name:      "unknown preset",
+			stopwords: &models.StopwordConfig{Preset: "de"},
+			wantErr:   `preset "de" not known`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateKeywordSearch(tt.stopwords, searchparams.KeywordOperator{})
+			if tt.wantErr == "" {
+				assert.Nil(t, err)
+				return
+			}
+			if assert.NotNil(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateKeywordSearch(t *testing.T) {
+	explorer := &Explorer{}
+
+	t.Run("valid search operator", func(t *testing.T) {
+		err := explorer.validateKeywordSearch(dto.GetParams{
+			KeywordRanking: &searchparams.KeywordRanking{
+				Query:          "a b c",
+				SearchOperator: searchparams.KeywordOperator{MinimumShouldMatch: "2"},
+			},
+		})
+		assert.Nil(t, err)
+	})
+
+	t.Run("invalid bm25 search operator", func(t *testing.T) {
+		err := explorer.validateKeywordSearch(dto.GetParams{
+			KeywordRanking: &searchparams.KeywordRanking{
+				Query:          "a b c",
+				SearchOperator: searchparams.KeywordOperator{Operator: "xor"},
+			},
+		})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("invalid hybrid search operator", func(t *testing.T) {
+		err := explorer.validateKeywordSearch(dto.GetParams{
+			HybridSearch: &searchparams.HybridSearch{
+				Query: "a b c",
+				SearchOperator: searchparams.KeywordOperator{
+					Operator: searchparams.KeywordOperatorAnd, MinimumShouldMatch: "1",
+				},
+			},
+		})
+		assert.NotNil(t, err)
+	})
+}
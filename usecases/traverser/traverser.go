@@ -22,9 +22,12 @@ import (
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/masking"
 	"github.com/weaviate/weaviate/usecases/modules"
+	"github.com/weaviate/weaviate/usecases/qos"
 	"github.com/weaviate/weaviate/usecases/ratelimiter"
 	"github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/usage"
 )
 
 // Traverser can be used to dynamically traverse the knowledge graph
@@ -39,6 +42,9 @@ type Traverser struct {
 	targetVectorParamHelper *TargetVectorParamHelper
 	metrics                 *Metrics
 	ratelimiter             *ratelimiter.Limiter
+	masker                  *masking.Masker
+	queries                 *usage.QueryCounter
+	scheduler               *qos.Scheduler
 }
 
 type VectorSearcher interface {
@@ -76,6 +82,23 @@ func NewTraverser(config *config.WeaviateConfig, logger logrus.FieldLogger, auth
 	}
 }
 
+// SetMasker sets the masking policies that are applied to Get results
+// before they are returned to the caller
+func (t *Traverser) SetMasker(masker *masking.Masker) {
+	t.masker = masker
+}
+
+// SetQueryCounter sets the counter used for usage reporting
+func (t *Traverser) SetQueryCounter(queries *usage.QueryCounter) {
+	t.queries = queries
+}
+
+// SetScheduler sets the scheduler that admits queries according to the QoS
+// class of their principal
+func (t *Traverser) SetScheduler(scheduler *qos.Scheduler) {
+	t.scheduler = scheduler
+}
+
 // SearchResult is a single search result. See wrapping Search Results for the Type
 type SearchResult struct {
 	Name      string
@@ -28,6 +28,10 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	t.metrics.QueriesAggregateInc(params.ClassName.String())
 	defer t.metrics.QueriesAggregateDec(params.ClassName.String())
 
+	if err := t.checkAggregateMasking(principal, params); err != nil {
+		return nil, err
+	}
+
 	inspector := newTypeInspector(t.schemaGetter.ReadOnlyClass)
 
 	// validate here, because filters can contain references that need to be authorized
@@ -35,6 +39,12 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		return nil, errors.Wrap(err, "invalid 'where' filter")
 	}
 
+	ctx, release, err := t.scheduler.Admit(ctx, principal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
 		className := params.ClassName.String()
 		err := t.nearParamsVector.validateNearParams(params.NearVector,
@@ -72,6 +82,13 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		params.Certainty = certainty
 	}
 
+	if params.Hybrid != nil {
+		if err := validateKeywordSearch(params.Hybrid.Stopwords,
+			params.Hybrid.SearchOperator); err != nil {
+			return nil, fmt.Errorf("invalid keyword search: %w", err)
+		}
+	}
+
 	if params.Hybrid != nil && params.Hybrid.Vector == nil {
 		var targetVectors []string
 		if len(params.Hybrid.TargetVectors) == 1 {
@@ -107,6 +124,26 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	if err != nil || res == nil {
 		return nil, err
 	}
+	t.queries.Aggregate(params.ClassName.String(), params.Tenant)
 
 	return inspector.WithTypes(res, *params)
 }
+
+// checkAggregateMasking rejects aggregations over properties that are masked
+// for the principal, since results such as topOccurrences or the grouped by
+// value would reveal their original values
+func (t *Traverser) checkAggregateMasking(principal *models.Principal, params *aggregation.Params) error {
+	rules := t.masker.ForPrincipal(principal)
+	if rules == nil {
+		return nil
+	}
+
+	props := make([]string, 0, len(params.Properties)+1)
+	for _, prop := range params.Properties {
+		props = append(props, prop.Name.String())
+	}
+	if params.GroupBy != nil {
+		props = append(props, params.GroupBy.Property.String())
+	}
+	return rules.CheckAggregate(params.ClassName.String(), props)
+}
@@ -36,7 +36,25 @@ func (t *Traverser) Explore(ctx context.Context,
 		return nil, err
 	}
 
-	return t.explorer.CrossClassVectorSearch(ctx, params)
+	ctx, release, err := t.scheduler.Admit(ctx, principal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	res, err := t.explorer.CrossClassVectorSearch(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// like listing objects, an exploration counts as a query of every
+	// collection it returned objects of
+	classes := make([]string, len(res))
+	for i := range res {
+		classes[i] = res[i].ClassName
+	}
+	t.queries.GetPerCollection(classes, "")
+	return res, nil
 }
 
 // ExploreParams are the parameters used by the GraphQL `Explore { }` API
@@ -47,4 +65,20 @@ type ExploreParams struct {
 	Limit             int
 	ModuleParams      map[string]interface{}
 	WithCertaintyProp bool
+	// Collections restricts the exploration to the given collections and
+	// merges their results by weighted score. All collections are searched
+	// if it is empty.
+	Collections []ExploreCollection
+}
+
+// ExploreCollection is a single collection of a federated exploration
+type ExploreCollection struct {
+	Name string
+	// Weight multiplies the similarity of the hits of this collection
+	Weight float64
+	// TargetVector overrides the target vector of the search for this
+	// collection, e.g. if the compatible named vectors are named differently
+	TargetVector string
+	// Tenant is searched in a multi-tenant collection
+	Tenant string
 }
@@ -31,6 +31,14 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 ) ([]interface{}, error) {
 	before := time.Now()
 
+	// queries queued by their QoS class must not hold a slot of the overall
+	// rate limit while they wait
+	ctx, release, err := t.scheduler.Admit(ctx, principal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	ok := t.ratelimiter.TryInc()
 	if !ok {
 		// we currently have no concept of error status code or typed errors in
@@ -64,7 +72,22 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		}
 	}
 
-	return t.explorer.GetClass(ctx, params)
+	// masking is applied after the search, so that filters, sorting and
+	// ranking still operate on the original values. Modules computing
+	// additional properties would see them as well, so they are rejected.
+	rules := t.masker.ForPrincipal(principal)
+	if err := rules.CheckModuleAdditional(params.ClassName, params.AdditionalProperties.ModuleParams); err != nil {
+		return nil, err
+	}
+
+	res, err := t.explorer.GetClass(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	t.queries.Get(params.ClassName, params.Tenant)
+
+	rules.MaskGetResults(params.ClassName, res)
+	return res, nil
 }
 
 // probeForRefDepthLimit checks to ensure reference nesting depth doesn't exceed the limit
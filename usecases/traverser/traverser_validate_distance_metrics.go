@@ -25,7 +25,7 @@ import (
 
 func (t *Traverser) validateExploreDistance(params ExploreParams) error {
 	targetVectors := t.extractTargetVectors(params)
-	distType, err := t.validateCrossClassDistanceCompatibility(targetVectors)
+	distType, err := t.validateCrossClassDistanceCompatibility(targetVectors, params.Collections)
 	if err != nil {
 		return err
 	}
@@ -36,9 +36,20 @@ func (t *Traverser) validateExploreDistance(params ExploreParams) error {
 // ensures that all classes are configured with the same distance type.
 // if all classes are configured with the same type, said type is returned.
 // otherwise an error indicating which classes are configured differently.
-func (t *Traverser) validateCrossClassDistanceCompatibility(targetVectors []string) (distType string, err error) {
+// if collections are given, only those classes are considered.
+func (t *Traverser) validateCrossClassDistanceCompatibility(targetVectors []string,
+	collections []ExploreCollection,
+) (distType string, err error) {
+	collectionsByName := make(map[string]ExploreCollection, len(collections))
+	for _, collection := range collections {
+		collectionsByName[collection.Name] = collection
+	}
+
 	s := t.schemaGetter.GetSchemaSkipAuth()
 	if s.Objects == nil {
+		if len(collections) > 0 {
+			return "", fmt.Errorf("collection %q not found", collections[0].Name)
+		}
 		return common.DefaultDistanceMetric, nil
 	}
 
@@ -60,20 +71,38 @@ func (t *Traverser) validateCrossClassDistanceCompatibility(targetVectors []stri
 			continue
 		}
 
-		vectorConfig, assertErr := schemaConfig.TypeAssertVectorIndex(class, targetVectors)
+		classTargetVectors := targetVectors
+		if len(collectionsByName) > 0 {
+			collection, ok := collectionsByName[class.Class]
+			if !ok {
+				continue
+			}
+			if collection.TargetVector != "" {
+				classTargetVectors = []string{collection.TargetVector}
+			}
+		}
+
+		vectorConfig, assertErr := schemaConfig.TypeAssertVectorIndex(class, classTargetVectors)
 		if assertErr != nil {
 			err = assertErr
 			return
 		}
 
 		if len(vectorConfig) == 0 {
-			err = fmt.Errorf("empty vectorConfig fot %v, %v", class, targetVectors)
+			err = fmt.Errorf("empty vectorConfig fot %v, %v", class, classTargetVectors)
 		}
 
 		distancerTypes[vectorConfig[0].DistanceName()] = struct{}{}
 		classDistanceConfigs[class.Class] = vectorConfig[0].DistanceName()
 	}
 
+	for _, collection := range collections {
+		if _, ok := classDistanceConfigs[collection.Name]; !ok {
+			err = fmt.Errorf("collection %q not found", collection.Name)
+			return
+		}
+	}
+
 	if len(distancerTypes) != 1 {
 		err = crossClassDistCompatError(classDistanceConfigs)
 		return
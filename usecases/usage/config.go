@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package usage
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	SinkFile = "file"
+	SinkS3   = "s3"
+	SinkHTTP = "http"
+
+	DefaultInterval   = time.Hour
+	DefaultS3Endpoint = "s3.amazonaws.com"
+)
+
+// Config of usage reporting, which is disabled if no sink is set
+type Config struct {
+	// Sink is one of "file", "s3" or "http"
+	Sink     string        `json:"sink" yaml:"sink"`
+	Interval time.Duration `json:"interval" yaml:"interval"`
+
+	// FilePath is the file reports are appended to, one JSON document per line
+	FilePath string `json:"file_path" yaml:"file_path"`
+
+	// Reports are stored as {S3Path}/{node}/{timestamp}.json
+	S3Bucket   string `json:"s3_bucket" yaml:"s3_bucket"`
+	S3Path     string `json:"s3_path" yaml:"s3_path"`
+	S3Endpoint string `json:"s3_endpoint" yaml:"s3_endpoint"`
+	// S3UseSSL defaults to true if unset
+	S3UseSSL *bool `json:"s3_use_ssl" yaml:"s3_use_ssl"`
+
+	// Reports are sent as the body of a POST request to HTTPURL
+	HTTPURL   string `json:"http_url" yaml:"http_url"`
+	HTTPToken string `json:"-" yaml:"-"`
+}
+
+func (c Config) Enabled() bool {
+	return c.Sink != ""
+}
+
+func (c Config) s3UseSSL() bool {
+	return c.S3UseSSL == nil || *c.S3UseSSL
+}
+
+func (c Config) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	if c.Interval <= 0 {
+		return fmt.Errorf("usage.interval must be greater than 0")
+	}
+
+	switch c.Sink {
+	case SinkFile:
+		if c.FilePath == "" {
+			return fmt.Errorf("usage.file_path is required for sink %q", c.Sink)
+		}
+	case SinkS3:
+		if c.S3Bucket == "" {
+			return fmt.Errorf("usage.s3_bucket is required for sink %q", c.Sink)
+		}
+	case SinkHTTP:
+		if c.HTTPURL == "" {
+			return fmt.Errorf("usage.http_url is required for sink %q", c.Sink)
+		}
+	default:
+		return fmt.Errorf("usage.sink must be one of %q, %q or %q, got %q",
+			SinkFile, SinkS3, SinkHTTP, c.Sink)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package usage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr string
+	}{
+		{
+			name: "disabled",
+			cfg:  Config{},
+		},
+		{
+			name: "file",
+			cfg:  Config{Sink: SinkFile, Interval: time.Hour, FilePath: "/tmp/usage.jsonl"},
+		},
+		{
+			name:    "file without path",
+			cfg:     Config{Sink: SinkFile, Interval: time.Hour},
+			wantErr: "usage.file_path",
+		},
+		{
+			name: "s3",
+			cfg:  Config{Sink: SinkS3, Interval: time.Hour, S3Bucket: "usage"},
+		},
+		{
+			name:    "s3 without bucket",
+			cfg:     Config{Sink: SinkS3, Interval: time.Hour},
+			wantErr: "usage.s3_bucket",
+		},
+		{
+			name: "http",
+			cfg:  Config{Sink: SinkHTTP, Interval: time.Hour, HTTPURL: "http://billing"},
+		},
+		{
+			name:    "http without url",
+			cfg:     Config{Sink: SinkHTTP, Interval: time.Hour},
+			wantErr: "usage.http_url",
+		},
+		{
+			name:    "no interval",
+			cfg:     Config{Sink: SinkFile, FilePath: "/tmp/usage.jsonl"},
+			wantErr: "usage.interval",
+		},
+		{
+			name:    "unknown sink",
+			cfg:     Config{Sink: "kafka", Interval: time.Hour},
+			wantErr: "usage.sink",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr == "" {
+				assert.Nil(t, err)
+				return
+			}
+			if assert.NotNil(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
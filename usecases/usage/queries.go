@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package usage
+
+import "sync"
+
+type queryKey struct {
+	collection string
+	tenant     string
+}
+
+// QueryCounter counts queries per collection and tenant. A nil QueryCounter
+// is valid and counts nothing, so callers don't need to check whether usage
+// reporting is enabled.
+type QueryCounter struct {
+	sync.Mutex
+	counts map[queryKey]*QueryUsage
+}
+
+func NewQueryCounter() *QueryCounter {
+	return &QueryCounter{counts: map[queryKey]*QueryUsage{}}
+}
+
+// Get counts a search or list query
+func (c *QueryCounter) Get(collection, tenant string) {
+	if c == nil {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	c.entry(collection, tenant).Get++
+}
+
+// GetPerCollection counts a query across collections once for every
+// collection in collections, which may contain duplicates
+func (c *QueryCounter) GetPerCollection(collections []string, tenant string) {
+	if c == nil {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	seen := make(map[string]struct{}, len(collections))
+	for _, collection := range collections {
+		if _, ok := seen[collection]; ok {
+			continue
+		}
+		seen[collection] = struct{}{}
+		c.entry(collection, tenant).Get++
+	}
+}
+
+// Aggregate counts an aggregation
+func (c *QueryCounter) Aggregate(collection, tenant string) {
+	if c == nil {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	c.entry(collection, tenant).Aggregate++
+}
+
+func (c *QueryCounter) entry(collection, tenant string) *QueryUsage {
+	key := queryKey{collection: collection, tenant: tenant}
+	q, ok := c.counts[key]
+	if !ok {
+		q = &QueryUsage{}
+		c.counts[key] = q
+	}
+	return q
+}
+
+// reset returns all counts and starts counting from zero
+func (c *QueryCounter) reset() map[queryKey]*QueryUsage {
+	if c == nil {
+		return nil
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	counts := c.counts
+	c.counts = map[queryKey]*QueryUsage{}
+	return counts
+}
+
+// restore adds counts which could not be reported back
+func (c *QueryCounter) restore(counts map[queryKey]*QueryUsage) {
+	if c == nil {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	for key, q := range counts {
+		c.entry(key.collection, key.tenant).add(*q)
+	}
+}
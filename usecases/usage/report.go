@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package usage periodically collects how much data each collection and
+// tenant holds on a node and how often it is queried, and writes it to a
+// configurable sink. It is meant for billing and capacity trending.
+//
+// Every node reports independently. Storage related numbers cover the shards
+// held by the reporting node, so replicas are counted once per node. Queries
+// are counted on the node that received the request, and each report only
+// contains the queries since the previous report. A consumer therefore gets
+// the cluster-wide numbers by summing up the reports of all nodes.
+//
+// A report is encoded as a single JSON document, see Report for the schema.
+// The schema is versioned through Report.SchemaVersion; fields are only ever
+// added within a version.
+package usage
+
+import "time"
+
+// SchemaVersion is the version of the Report schema
+const SchemaVersion = 1
+
+// Report is the usage of a single node
+type Report struct {
+	// SchemaVersion of this report, currently always 1
+	SchemaVersion int `json:"schemaVersion"`
+	// Node is the name of the reporting node
+	Node string `json:"node"`
+	// Version is the Weaviate version of the reporting node
+	Version string `json:"version"`
+	// CollectedAt is the time the report was collected
+	CollectedAt time.Time `json:"collectedAt"`
+	// IntervalStart is the beginning of the time window the query counts
+	// cover, the window ends at CollectedAt
+	IntervalStart time.Time `json:"intervalStart"`
+	// Collections contains all collections with at least one shard or query
+	// on the reporting node, sorted by name
+	Collections []*CollectionUsage `json:"collections"`
+}
+
+// CollectionUsage is the usage of a single collection on a node
+type CollectionUsage struct {
+	Name         string `json:"name"`
+	MultiTenancy bool   `json:"multiTenancy"`
+	// Shards contains the local shards, which are the tenants of multi-tenant
+	// collections. Tenants which are not active on the node are omitted.
+	Shards []*ShardUsage `json:"shards"`
+	// Queries are the queries against all shards of the collection
+	Queries QueryUsage `json:"queries"`
+}
+
+// ShardUsage is the usage of a single shard or tenant on a node
+type ShardUsage struct {
+	Name string `json:"name"`
+	// Loaded is false for lazily loaded shards which have not been accessed
+	// yet. Only their storage is reported, as counting their objects and
+	// vectors would require loading them.
+	Loaded      bool  `json:"loaded"`
+	ObjectCount int64 `json:"objectCount"`
+	// StorageBytes is the size of the flushed segments of a loaded shard, or
+	// the size of the shard directory if the shard is not loaded
+	StorageBytes int64 `json:"storageBytes"`
+	// Vectors contains one entry per vector index; the legacy unnamed vector
+	// has an empty name
+	Vectors []*VectorUsage `json:"vectors"`
+	// Queries against this tenant, only set for multi-tenant collections
+	Queries *QueryUsage `json:"queries,omitempty"`
+}
+
+// VectorUsage is the usage of a single vector index of a shard
+type VectorUsage struct {
+	Name string `json:"name"`
+	// Dimensionality is the length of the vectors
+	Dimensionality int64 `json:"dimensionality"`
+	// Count is the number of vectors
+	Count int64 `json:"count"`
+	// Dimensions is the total number of dimensions, Count * Dimensionality
+	Dimensions int64 `json:"dimensions"`
+}
+
+// QueryUsage counts queries by type
+type QueryUsage struct {
+	// Get counts search and list queries, through GraphQL or gRPC
+	Get int64 `json:"get"`
+	// Aggregate counts aggregations
+	Aggregate int64 `json:"aggregate"`
+}
+
+func (q *QueryUsage) add(other QueryUsage) {
+	q.Get += other.Get
+	q.Aggregate += other.Aggregate
+}
@@ -0,0 +1,181 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package usage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+type collector interface {
+	LocalUsage(ctx context.Context) ([]*CollectionUsage, error)
+}
+
+// Reporter periodically collects the usage of the local node and writes it
+// to a sink
+type Reporter struct {
+	node      string
+	version   string
+	collector collector
+	queries   *QueryCounter
+	sink      Sink
+	interval  time.Duration
+	logger    logrus.FieldLogger
+	shutdown  chan struct{}
+	done      chan struct{}
+
+	// lock serializes reports, so that query counts of a failed report can
+	// be carried over to the next one
+	lock          sync.Mutex
+	intervalStart time.Time
+}
+
+func NewReporter(node, version string, collector collector, queries *QueryCounter,
+	sink Sink, interval time.Duration, logger logrus.FieldLogger,
+) *Reporter {
+	return &Reporter{
+		node:          node,
+		version:       version,
+		collector:     collector,
+		queries:       queries,
+		sink:          sink,
+		interval:      interval,
+		logger:        logger.WithField("action", "usage_report"),
+		shutdown:      make(chan struct{}),
+		done:          make(chan struct{}),
+		intervalStart: time.Now(),
+	}
+}
+
+// Start reports usage every interval until Stop is called
+func (r *Reporter) Start() {
+	f := func() {
+		defer close(r.done)
+
+		t := time.NewTicker(r.interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-r.shutdown:
+				return
+			case <-t.C:
+				if err := r.Report(context.Background()); err != nil {
+					r.logger.
+						WithField("retry_at", time.Now().Add(r.interval).Format(time.RFC3339)).
+						Error(err.Error())
+				}
+			}
+		}
+	}
+	enterrors.GoWrapper(f, r.logger)
+
+	r.logger.
+		WithField("interval", r.interval).
+		Info("usage reporting started")
+}
+
+// Stop ends periodic reporting and writes a final report, so that no query
+// counts are lost
+func (r *Reporter) Stop(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("shutdown usage reporting: %w", ctx.Err())
+	case r.shutdown <- struct{}{}:
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("shutdown usage reporting: %w", ctx.Err())
+	case <-r.done:
+	}
+
+	return r.Report(ctx)
+}
+
+// Report collects the current usage and writes it to the sink. If writing
+// fails, the query counts are kept for the next report.
+func (r *Reporter) Report(ctx context.Context) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	counts := r.queries.reset()
+	report, err := r.collect(ctx, counts)
+	if err == nil {
+		err = r.sink.Write(ctx, report)
+	}
+	if err != nil {
+		r.queries.restore(counts)
+		return fmt.Errorf("report usage: %w", err)
+	}
+
+	r.intervalStart = report.CollectedAt
+	r.logger.
+		WithField("collections", len(report.Collections)).
+		Debug("usage reported")
+	return nil
+}
+
+func (r *Reporter) collect(ctx context.Context, counts map[queryKey]*QueryUsage) (*Report, error) {
+	collections, err := r.collector.LocalUsage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("collect usage: %w", err)
+	}
+
+	byName := make(map[string]*CollectionUsage, len(collections))
+	for _, col := range collections {
+		byName[col.Name] = col
+	}
+
+	for key, q := range counts {
+		col, ok := byName[key.collection]
+		if !ok {
+			// queried, but no local shards
+			col = &CollectionUsage{Name: key.collection, Shards: []*ShardUsage{}}
+			byName[key.collection] = col
+			collections = append(collections, col)
+		}
+		col.Queries.add(*q)
+
+		if key.tenant == "" {
+			continue
+		}
+		for _, shard := range col.Shards {
+			if shard.Name == key.tenant {
+				if shard.Queries == nil {
+					shard.Queries = &QueryUsage{}
+				}
+				shard.Queries.add(*q)
+				break
+			}
+		}
+	}
+
+	sort.Slice(collections, func(i, j int) bool {
+		return collections[i].Name < collections[j].Name
+	})
+
+	return &Report{
+		SchemaVersion: SchemaVersion,
+		Node:          r.node,
+		Version:       r.version,
+		CollectedAt:   time.Now().UTC(),
+		IntervalStart: r.intervalStart.UTC(),
+		Collections:   collections,
+	}, nil
+}
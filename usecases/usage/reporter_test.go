@@ -0,0 +1,286 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package usage
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCollector struct {
+	collections []*CollectionUsage
+	err         error
+}
+
+func (f *fakeCollector) LocalUsage(ctx context.Context) ([]*CollectionUsage, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	// a fresh copy per call, just like the db would return
+	out := make([]*CollectionUsage, len(f.collections))
+	for i, col := range f.collections {
+		c := *col
+		c.Shards = make([]*ShardUsage, len(col.Shards))
+		for j, shard := range col.Shards {
+			s := *shard
+			c.Shards[j] = &s
+		}
+		out[i] = &c
+	}
+	return out, nil
+}
+
+type fakeSink struct {
+	reports []*Report
+	err     error
+}
+
+func (f *fakeSink) Write(ctx context.Context, report *Report) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.reports = append(f.reports, report)
+	return nil
+}
+
+func newTestCollector() *fakeCollector {
+	return &fakeCollector{collections: []*CollectionUsage{
+		{
+			Name:         "Tenants",
+			MultiTenancy: true,
+			Shards: []*ShardUsage{
+				{Name: "tenant1", Loaded: true, ObjectCount: 10, StorageBytes: 1000},
+				{Name: "tenant2", Loaded: false, StorageBytes: 500},
+			},
+		},
+		{
+			Name: "Articles",
+			Shards: []*ShardUsage{{
+				Name: "abc", Loaded: true, ObjectCount: 3, StorageBytes: 300,
+				Vectors: []*VectorUsage{{Name: "", Dimensionality: 4, Count: 3, Dimensions: 12}},
+			}},
+		},
+	}}
+}
+
+func TestReporter_Report(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	queries := NewQueryCounter()
+	sink := &fakeSink{}
+	r := NewReporter("node1", "1.2.3", newTestCollector(), queries, sink, time.Hour, logger)
+
+	queries.Get("Articles", "")
+	queries.Get("Articles", "")
+	queries.Aggregate("Articles", "")
+	queries.Get("Tenants", "tenant1")
+	queries.Aggregate("Tenants", "tenant2")
+	queries.Get("Missing", "")
+
+	require.Nil(t, r.Report(context.Background()))
+	require.Len(t, sink.reports, 1)
+
+	report := sink.reports[0]
+	assert.Equal(t, SchemaVersion, report.SchemaVersion)
+	assert.Equal(t, "node1", report.Node)
+	assert.Equal(t, "1.2.3", report.Version)
+	assert.False(t, report.CollectedAt.Before(report.IntervalStart))
+
+	require.Len(t, report.Collections, 3)
+	articles, missing, tenants := report.Collections[0], report.Collections[1], report.Collections[2]
+
+	assert.Equal(t, "Articles", articles.Name)
+	assert.Equal(t, QueryUsage{Get: 2, Aggregate: 1}, articles.Queries)
+	assert.Nil(t, articles.Shards[0].Queries)
+	assert.Equal(t, int64(12), articles.Shards[0].Vectors[0].Dimensions)
+
+	assert.Equal(t, "Missing", missing.Name)
+	assert.Equal(t, QueryUsage{Get: 1}, missing.Queries)
+	assert.Empty(t, missing.Shards)
+
+	assert.Equal(t, "Tenants", tenants.Name)
+	assert.Equal(t, QueryUsage{Get: 1, Aggregate: 1}, tenants.Queries)
+	assert.Equal(t, &QueryUsage{Get: 1}, tenants.Shards[0].Queries)
+	assert.Equal(t, &QueryUsage{Aggregate: 1}, tenants.Shards[1].Queries)
+
+	t.Run("query counts are reset after a report", func(t *testing.T) {
+		queries.Get("Articles", "")
+		require.Nil(t, r.Report(context.Background()))
+		require.Len(t, sink.reports, 2)
+
+		report := sink.reports[1]
+		assert.Equal(t, sink.reports[0].CollectedAt, report.IntervalStart)
+		require.Len(t, report.Collections, 2)
+		assert.Equal(t, QueryUsage{Get: 1}, report.Collections[0].Queries)
+		assert.Equal(t, QueryUsage{}, report.Collections[1].Queries)
+	})
+}
+
+func TestReporter_FailedReportKeepsQueryCounts(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	for _, tc := range []struct {
+		name      string
+		collector *fakeCollector
+		sink      *fakeSink
+	}{
+		{
+			name:      "collecting fails",
+			collector: &fakeCollector{err: errors.New("collect failed")},
+			sink:      &fakeSink{},
+		},
+		{
+			name:      "writing fails",
+			collector: newTestCollector(),
+			sink:      &fakeSink{err: errors.New("write failed")},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			queries := NewQueryCounter()
+			r := NewReporter("node1", "1.2.3", tc.collector, queries, tc.sink, time.Hour, logger)
+
+			queries.Get("Articles", "")
+			require.NotNil(t, r.Report(context.Background()))
+
+			// queries in the meantime are added up
+			queries.Get("Articles", "")
+			tc.collector.err = nil
+			tc.sink.err = nil
+			require.Nil(t, r.Report(context.Background()))
+
+			require.Len(t, tc.sink.reports, 1)
+			var articles *CollectionUsage
+			for _, col := range tc.sink.reports[0].Collections {
+				if col.Name == "Articles" {
+					articles = col
+				}
+			}
+			require.NotNil(t, articles)
+			assert.Equal(t, QueryUsage{Get: 2}, articles.Queries)
+		})
+	}
+}
+
+func TestReporter_StopWritesFinalReport(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	queries := NewQueryCounter()
+	sink := &fakeSink{}
+	r := NewReporter("node1", "1.2.3", newTestCollector(), queries, sink, time.Hour, logger)
+
+	r.Start()
+	queries.Get("Articles", "")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.Nil(t, r.Stop(ctx))
+
+	require.Len(t, sink.reports, 1)
+	assert.Equal(t, QueryUsage{Get: 1}, sink.reports[0].Collections[0].Queries)
+}
+
+func TestQueryCounter_Nil(t *testing.T) {
+	var queries *QueryCounter
+	assert.NotPanics(t, func() {
+		queries.Get("Articles", "")
+		queries.Aggregate("Articles", "")
+		queries.GetPerCollection([]string{"Articles"}, "")
+		queries.restore(queries.reset())
+	})
+}
+
+func TestQueryCounter_GetPerCollection(t *testing.T) {
+	queries := NewQueryCounter()
+	queries.GetPerCollection([]string{"Articles", "Authors", "Articles"}, "")
+	queries.GetPerCollection(nil, "")
+
+	counts := queries.reset()
+	require.Len(t, counts, 2)
+	assert.Equal(t, QueryUsage{Get: 1}, *counts[queryKey{collection: "Articles"}])
+	assert.Equal(t, QueryUsage{Get: 1}, *counts[queryKey{collection: "Authors"}])
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "usage.jsonl")
+	sink, err := NewSink(Config{Sink: SinkFile, FilePath: path})
+	require.Nil(t, err)
+
+	for _, node := range []string{"node1", "node2"} {
+		require.Nil(t, sink.Write(context.Background(), &Report{SchemaVersion: SchemaVersion, Node: node}))
+	}
+
+	f, err := os.Open(path)
+	require.Nil(t, err)
+	defer f.Close()
+
+	var nodes []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var report Report
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), &report))
+		nodes = append(nodes, report.Node)
+	}
+	require.Nil(t, scanner.Err())
+	assert.Equal(t, []string{"node1", "node2"}, nodes)
+}
+
+func TestHTTPSink(t *testing.T) {
+	var received []Report
+	var auth []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") == "Bearer wrong" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var report Report
+		if err := json.NewDecoder(r.Body).Decode(&report); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received = append(received, report)
+	}))
+	defer server.Close()
+
+	sink, err := NewSink(Config{Sink: SinkHTTP, HTTPURL: server.URL, HTTPToken: "secret"})
+	require.Nil(t, err)
+	require.Nil(t, sink.Write(context.Background(), &Report{SchemaVersion: SchemaVersion, Node: "node1"}))
+
+	sink, err = NewSink(Config{Sink: SinkHTTP, HTTPURL: server.URL, HTTPToken: "wrong"})
+	require.Nil(t, err)
+	err = sink.Write(context.Background(), &Report{SchemaVersion: SchemaVersion, Node: "node1"})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "401")
+
+	assert.Equal(t, []string{"Bearer secret", "Bearer wrong"}, auth)
+	require.Len(t, received, 1)
+	assert.Equal(t, "node1", received[0].Node)
+}
+
+func TestS3SinkObjectName(t *testing.T) {
+	sink := &s3Sink{path: "usage/reports"}
+	report := &Report{
+		Node:        "node1",
+		CollectedAt: time.Date(2024, 5, 1, 13, 4, 5, 0, time.UTC),
+	}
+	assert.Equal(t, "usage/reports/node1/20240501T130405Z.json", sink.objectName(report))
+}
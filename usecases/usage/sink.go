@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package usage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// Sink stores usage reports
+type Sink interface {
+	Write(ctx context.Context, report *Report) error
+}
+
+// NewSink creates the sink configured in cfg
+func NewSink(cfg Config) (Sink, error) {
+	switch cfg.Sink {
+	case SinkFile:
+		return &fileSink{path: cfg.FilePath}, nil
+	case SinkS3:
+		return newS3Sink(cfg)
+	case SinkHTTP:
+		return &httpSink{
+			url:    cfg.HTTPURL,
+			token:  cfg.HTTPToken,
+			client: &http.Client{Timeout: 30 * time.Second},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown usage sink %q", cfg.Sink)
+	}
+}
+
+type fileSink struct {
+	sync.Mutex
+	path string
+}
+
+func (s *fileSink) Write(ctx context.Context, report *Report) error {
+	b, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("marshal report: %w", err)
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("open %s: %w", s.path, err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("write %s: %w", s.path, err)
+	}
+	return f.Sync()
+}
+
+type httpSink struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+func (s *httpSink) Write(ctx context.Context, report *Report) error {
+	b, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("marshal report: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("request unsuccessful, status code: %d, body: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+type s3Sink struct {
+	client *minio.Client
+	bucket string
+	path   string
+}
+
+func newS3Sink(cfg Config) (*s3Sink, error) {
+	region := os.Getenv("AWS_REGION")
+	if len(region) == 0 {
+		region = os.Getenv("AWS_DEFAULT_REGION")
+	}
+
+	var creds *credentials.Credentials
+	if (os.Getenv("AWS_ACCESS_KEY_ID") != "" || os.Getenv("AWS_ACCESS_KEY") != "") &&
+		(os.Getenv("AWS_SECRET_ACCESS_KEY") != "" || os.Getenv("AWS_SECRET_KEY") != "") {
+		creds = credentials.NewEnvAWS()
+	} else {
+		creds = credentials.NewIAM("")
+		if _, err := creds.GetWithContext(nil); err != nil {
+			// can be anonymous access
+			creds = credentials.NewEnvAWS()
+		}
+	}
+
+	endpoint := cfg.S3Endpoint
+	if endpoint == "" {
+		endpoint = DefaultS3Endpoint
+	}
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  creds,
+		Region: region,
+		Secure: cfg.s3UseSSL(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create s3 client: %w", err)
+	}
+	return &s3Sink{client: client, bucket: cfg.S3Bucket, path: cfg.S3Path}, nil
+}
+
+func (s *s3Sink) Write(ctx context.Context, report *Report) error {
+	b, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("marshal report: %w", err)
+	}
+
+	objectName := s.objectName(report)
+	_, err = s.client.PutObject(ctx, s.bucket, objectName, bytes.NewReader(b), int64(len(b)),
+		minio.PutObjectOptions{ContentType: "application/json"})
+	if err != nil {
+		return fmt.Errorf("put object %s: %w", objectName, err)
+	}
+	return nil
+}
+
+func (s *s3Sink) objectName(report *Report) string {
+	return path.Join(s.path, report.Node,
+		report.CollectedAt.UTC().Format("20060102T150405Z")+".json")
+}